@@ -0,0 +1,90 @@
+package image
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+)
+
+// cache keeps the images of at most size pets for ttl, the least recently used pet is evicted first
+type cache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	petId    string
+	images   []*proto.Image
+	storedAt time.Time
+}
+
+func newCache(size int, ttl time.Duration) *cache {
+	return &cache{size: size, ttl: ttl, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *cache) get(petId string) ([]*proto.Image, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[petId]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if time.Since(entry.storedAt) > c.ttl {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.images, true
+}
+
+func (c *cache) put(petId string, images []*proto.Image) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[petId]; ok {
+		c.remove(elem)
+	}
+	c.entries[petId] = c.order.PushFront(&cacheEntry{petId: petId, images: images, storedAt: time.Now()})
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *cache) delete(petId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[petId]; ok {
+		c.remove(elem)
+	}
+}
+
+// deleteImage evicts the pets having the image
+func (c *cache) deleteImage(imageId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, elem := range c.entries {
+		for _, image := range elem.Value.(*cacheEntry).images {
+			if image.Id == imageId {
+				c.remove(elem)
+				break
+			}
+		}
+	}
+}
+
+func (c *cache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).petId)
+}
@@ -0,0 +1,276 @@
+package image
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTimeout          = 5 * time.Second
+	defaultMaxRetries       = 2
+	defaultRetryBackoff     = 100 * time.Millisecond
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+	defaultCacheSize        = 1000
+	defaultCacheTTL         = 10 * time.Minute
+)
+
+var ErrCircuitOpen = status.Error(codes.Unavailable, "image service is unavailable (circuit open)")
+
+// Client wraps proto.ImageServiceClient with deadlines, retries, a circuit breaker and a last-known-good cache
+type Client struct {
+	client       proto.ImageServiceClient
+	timeout      time.Duration
+	maxRetries   int
+	retryBackoff time.Duration
+	breaker      *breaker
+	cache        *cache
+}
+
+func NewClient(client proto.ImageServiceClient, conf *config.Client) *Client {
+	c := &Client{
+		client:       client,
+		timeout:      defaultTimeout,
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+		breaker:      newBreaker(defaultBreakerThreshold, defaultBreakerCooldown),
+		cache:        newCache(defaultCacheSize, defaultCacheTTL),
+	}
+
+	if conf.Timeout > 0 {
+		c.timeout = time.Duration(conf.Timeout) * time.Millisecond
+	}
+	if conf.MaxRetries > 0 {
+		c.maxRetries = conf.MaxRetries
+	}
+	if conf.RetryBackoff > 0 {
+		c.retryBackoff = time.Duration(conf.RetryBackoff) * time.Millisecond
+	}
+	if conf.BreakerThreshold > 0 {
+		c.breaker.threshold = conf.BreakerThreshold
+	}
+	if conf.BreakerCooldown > 0 {
+		c.breaker.cooldown = time.Duration(conf.BreakerCooldown) * time.Second
+	}
+	if conf.CacheSize > 0 {
+		c.cache.size = conf.CacheSize
+	}
+	if conf.CacheTTL > 0 {
+		c.cache.ttl = time.Duration(conf.CacheTTL) * time.Second
+	}
+
+	return c
+}
+
+func (c *Client) Upload(ctx context.Context, in *proto.UploadImageRequest, opts ...grpc.CallOption) (res *proto.UploadImageResponse, err error) {
+	// uploading is not idempotent, so it is never retried
+	err = c.call(ctx, 0, func(ctx context.Context) (err error) {
+		res, err = c.client.Upload(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.delete(in.PetId)
+	return res, nil
+}
+
+func (c *Client) FindByPetId(ctx context.Context, in *proto.FindImageByPetIdRequest, opts ...grpc.CallOption) (res *proto.FindImageByPetIdResponse, err error) {
+	err = c.call(ctx, c.maxRetries, func(ctx context.Context) (err error) {
+		res, err = c.client.FindByPetId(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		images, ok := c.cache.get(in.PetId)
+		if !ok || !isTransient(err) {
+			return nil, err
+		}
+
+		log.Ctx(ctx).Warn().
+			Err(err).
+			Str("service", "image client").
+			Str("module", "find by petId").
+			Str("pet_id", in.PetId).
+			Msg("Serving cached images")
+		return &proto.FindImageByPetIdResponse{Images: images}, nil
+	}
+
+	c.cache.put(in.PetId, res.Images)
+
+	return res, nil
+}
+
+func (c *Client) AssignPet(ctx context.Context, in *proto.AssignPetRequest, opts ...grpc.CallOption) (res *proto.AssignPetResponse, err error) {
+	err = c.call(ctx, c.maxRetries, func(ctx context.Context) (err error) {
+		res, err = c.client.AssignPet(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.delete(in.PetId)
+	return res, nil
+}
+
+func (c *Client) Delete(ctx context.Context, in *proto.DeleteImageRequest, opts ...grpc.CallOption) (res *proto.DeleteImageResponse, err error) {
+	err = c.call(ctx, c.maxRetries, func(ctx context.Context) (err error) {
+		res, err = c.client.Delete(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.deleteImage(in.Id)
+	return res, nil
+}
+
+// Evict drops the cached images of the pet
+func (c *Client) Evict(petId string) {
+	c.cache.delete(petId)
+}
+
+// call runs fn with a deadline derived from ctx, retrying up to retries times with exponential backoff
+func (c *Client) call(ctx context.Context, retries int, fn func(context.Context) error) error {
+	backoff := c.retryBackoff
+
+	for attempt := 0; ; attempt++ {
+		if !c.breaker.allow() {
+			return ErrCircuitOpen
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		err := fn(callCtx)
+		cancel()
+
+		if err == nil {
+			c.breaker.success()
+			return nil
+		}
+		if ctx.Err() != nil || isNeutral(err) {
+			// the call was cut short by the caller or failed without an answer, it says nothing of the file service
+			c.breaker.neutral()
+			return err
+		}
+		if !isTransient(err) {
+			// the file service answered, so it is healthy even though the call failed
+			c.breaker.success()
+			return err
+		}
+		c.breaker.failure()
+
+		if attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNeutral(err error) bool {
+	switch status.Code(err) {
+	case codes.Canceled, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+type breaker struct {
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	threshold int
+	cooldown  time.Duration
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a call may go through, letting a single probe call through once the cooldown has elapsed
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case open:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = halfOpen
+		return true
+	case halfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = closed
+	b.failures = 0
+}
+
+// neutral lets another probe call through when the probe had no outcome
+func (b *breaker) neutral() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == halfOpen {
+		b.state = open
+	}
+}
+
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == halfOpen || b.failures >= b.threshold {
+		if b.state != open {
+			log.Warn().
+				Str("service", "image client").
+				Int("failures", b.failures).
+				Msg("Opening circuit breaker")
+		}
+		b.state = open
+		b.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,176 @@
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/image"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ImageClientTest struct {
+	suite.Suite
+	conf   *config.Client
+	req    *proto.FindImageByPetIdRequest
+	images []*proto.Image
+}
+
+func TestImageClient(t *testing.T) {
+	suite.Run(t, new(ImageClientTest))
+}
+
+func (t *ImageClientTest) SetupTest() {
+	t.conf = &config.Client{
+		MaxRetries:       2,
+		RetryBackoff:     1,
+		BreakerThreshold: 3,
+		BreakerCooldown:  60,
+	}
+	t.req = &proto.FindImageByPetIdRequest{PetId: faker.UUIDDigit()}
+	t.images = []*proto.Image{
+		{
+			Id:       faker.UUIDDigit(),
+			PetId:    t.req.PetId,
+			ImageUrl: faker.URL(),
+		},
+	}
+}
+
+func (t *ImageClientTest) TestFindByPetIdRetrySuccess() {
+	want := &proto.FindImageByPetIdResponse{Images: t.images}
+
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout")).Once()
+	c.On("FindByPetId", t.req).Return(want, nil).Once()
+
+	clt := NewClient(c, t.conf)
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+	c.AssertNumberOfCalls(t.T(), "FindByPetId", 2)
+}
+
+func (t *ImageClientTest) TestFindByPetIdNotRetryPermanentError() {
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.InvalidArgument, "Invalid pet id"))
+
+	clt := NewClient(c, t.conf)
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, status.Code(err))
+	c.AssertNumberOfCalls(t.T(), "FindByPetId", 1)
+}
+
+func (t *ImageClientTest) TestFindByPetIdCachedFallback() {
+	want := &proto.FindImageByPetIdResponse{Images: t.images}
+
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(want, nil).Once()
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(context.Background(), t.req)
+	assert.Nil(t.T(), err)
+
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *ImageClientTest) TestFindByPetIdCacheEvictsLeastRecentlyUsed() {
+	other := &proto.FindImageByPetIdRequest{PetId: faker.UUIDDigit()}
+	t.conf.CacheSize = 1
+
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil).Once()
+	c.On("FindByPetId", other).Return(&proto.FindImageByPetIdResponse{}, nil).Once()
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(context.Background(), t.req)
+	assert.Nil(t.T(), err)
+	_, err = clt.FindByPetId(context.Background(), other)
+	assert.Nil(t.T(), err)
+
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Unavailable, status.Code(err))
+}
+
+func (t *ImageClientTest) TestEvict() {
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil).Once()
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(context.Background(), t.req)
+	assert.Nil(t.T(), err)
+
+	clt.Evict(t.req.PetId)
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Unavailable, status.Code(err))
+}
+
+func (t *ImageClientTest) TestCircuitOpen() {
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(context.Background(), t.req)
+	assert.Equal(t.T(), codes.Unavailable, status.Code(err))
+
+	actual, err := clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrCircuitOpen, err)
+	c.AssertNumberOfCalls(t.T(), "FindByPetId", 3)
+}
+
+func (t *ImageClientTest) TestCanceledCallNotCountedByBreaker() {
+	t.conf.BreakerThreshold = 1
+
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout")).Once()
+	c.On("FindByPetId", t.req).Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(ctx, t.req)
+	assert.Equal(t.T(), codes.Unavailable, status.Code(err))
+
+	_, err = clt.FindByPetId(context.Background(), t.req)
+
+	assert.Nil(t.T(), err)
+}
+
+func (t *ImageClientTest) TestUnknownErrorNotClosingBreaker() {
+	t.conf.BreakerThreshold = 2
+
+	c := &mock.ClientMock{}
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout")).Once()
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unknown, "unknown")).Once()
+	c.On("FindByPetId", t.req).Return(nil, status.Error(codes.Unavailable, "Connection Timeout")).Once()
+	c.On("FindByPetId", t.req).Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+
+	clt := NewClient(c, t.conf)
+	_, err := clt.FindByPetId(context.Background(), t.req)
+	assert.Equal(t.T(), codes.Unknown, status.Code(err))
+
+	_, err = clt.FindByPetId(context.Background(), t.req)
+
+	assert.Equal(t.T(), ErrCircuitOpen, err)
+}
@@ -0,0 +1,207 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 2 * time.Second
+)
+
+// Check reports an error when the dependency it checks is unreachable
+type Check func(ctx context.Context) error
+
+// Conn is the part of *grpc.ClientConn a ConnCheck needs
+type Conn interface {
+	GetState() connectivity.State
+	Connect()
+	WaitForStateChange(ctx context.Context, state connectivity.State) bool
+}
+
+// Monitor periodically sets every watched service SERVING only while all of its dependencies are healthy
+type Monitor struct {
+	server   *health.Server
+	interval time.Duration
+	timeout  time.Duration
+	mu       sync.Mutex
+	checks   map[string]Check
+	services map[string][]string
+	failures map[string]error
+	shutdown bool
+}
+
+func NewMonitor(server *health.Server, conf *config.Health) *Monitor {
+	m := &Monitor{
+		server:   server,
+		interval: defaultInterval,
+		timeout:  defaultTimeout,
+		checks:   make(map[string]Check),
+		services: make(map[string][]string),
+		failures: make(map[string]error),
+	}
+
+	if conf.Interval > 0 {
+		m.interval = time.Duration(conf.Interval) * time.Second
+	}
+	if conf.Timeout > 0 {
+		m.timeout = time.Duration(conf.Timeout) * time.Millisecond
+	}
+
+	return m
+}
+
+// AddCheck registers the check of a dependency, the overall status "" depends on every registered dependency
+func (m *Monitor) AddCheck(name string, check Check) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.checks[name] = check
+	m.services[""] = append(m.services[""], name)
+}
+
+// Watch sets the dependencies the serving status of the service is derived from
+func (m *Monitor) Watch(service string, dependencies ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.services[service] = dependencies
+}
+
+// Check runs every check once and updates the serving statuses
+func (m *Monitor) Check(ctx context.Context) {
+	m.mu.Lock()
+	checks := make(map[string]Check, len(m.checks))
+	for name, check := range m.checks {
+		checks[name] = check
+	}
+	m.mu.Unlock()
+
+	failures := make(map[string]error, len(checks))
+	var wg sync.WaitGroup
+	var failuresMu sync.Mutex
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, m.timeout)
+			defer cancel()
+
+			if err := check(checkCtx); err != nil {
+				failuresMu.Lock()
+				failures[name] = err
+				failuresMu.Unlock()
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	m.update(failures)
+}
+
+// Run checks the dependencies every interval until ctx is done
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Check(ctx)
+		}
+	}
+}
+
+// Shutdown sets every service NOT_SERVING for good, so load balancers stop sending traffic before the server stops
+func (m *Monitor) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.shutdown = true
+	m.server.Shutdown()
+}
+
+func (m *Monitor) update(failures map[string]error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.shutdown {
+		return
+	}
+
+	for name := range m.checks {
+		err, failed := failures[name]
+		_, failing := m.failures[name]
+		switch {
+		case failed && !failing:
+			log.Warn().
+				Err(err).
+				Str("service", "health").
+				Str("module", name).
+				Msg("Dependency is unhealthy")
+		case !failed && failing:
+			log.Info().
+				Str("service", "health").
+				Str("module", name).
+				Msg("Dependency recovered")
+		}
+	}
+	m.failures = failures
+
+	for service, dependencies := range m.services {
+		servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+		for _, dependency := range dependencies {
+			if _, failed := failures[dependency]; failed {
+				servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+				break
+			}
+		}
+		m.server.SetServingStatus(service, servingStatus)
+	}
+}
+
+// DatabaseCheck pings the database
+func DatabaseCheck(db *gorm.DB) Check {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+// ConnCheck reports the state of a client connection, connecting it when idle and waiting while it is connecting
+func ConnCheck(conn Conn) Check {
+	return func(ctx context.Context) error {
+		state := conn.GetState()
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+
+		for state == connectivity.Idle || state == connectivity.Connecting {
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection is still %v: %w", state, ctx.Err())
+			}
+			state = conn.GetState()
+		}
+
+		if state != connectivity.Ready {
+			return fmt.Errorf("connection is %v", state)
+		}
+		return nil
+	}
+}
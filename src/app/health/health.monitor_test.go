@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type HealthMonitorTest struct {
+	suite.Suite
+	server      *health.Server
+	monitor     *Monitor
+	databaseErr error
+	fileErr     error
+}
+
+func TestHealthMonitor(t *testing.T) {
+	suite.Run(t, new(HealthMonitorTest))
+}
+
+func (t *HealthMonitorTest) SetupTest() {
+	t.server = health.NewServer()
+	t.monitor = NewMonitor(t.server, &config.Health{Timeout: 100})
+	t.databaseErr = nil
+	t.fileErr = nil
+
+	t.monitor.AddCheck("database", func(ctx context.Context) error { return t.databaseErr })
+	t.monitor.AddCheck("file", func(ctx context.Context) error { return t.fileErr })
+	t.monitor.Watch("user", "database")
+	t.monitor.Watch("pet", "database", "file")
+}
+
+func (t *HealthMonitorTest) status(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	res, err := t.server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	assert.Nil(t.T(), err)
+	return res.GetStatus()
+}
+
+func (t *HealthMonitorTest) TestAllHealthy() {
+	t.monitor.Check(context.Background())
+
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status(""))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status("user"))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status("pet"))
+}
+
+func (t *HealthMonitorTest) TestFileUnhealthy() {
+	t.fileErr = errors.New("connection refused")
+
+	t.monitor.Check(context.Background())
+
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status(""))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status("user"))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status("pet"))
+}
+
+func (t *HealthMonitorTest) TestDatabaseRecovered() {
+	t.databaseErr = errors.New("connection refused")
+	t.monitor.Check(context.Background())
+
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status("user"))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status("pet"))
+
+	t.databaseErr = nil
+	t.monitor.Check(context.Background())
+
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status("user"))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_SERVING, t.status("pet"))
+}
+
+func (t *HealthMonitorTest) TestShutdown() {
+	t.monitor.Check(context.Background())
+
+	t.monitor.Shutdown()
+	t.monitor.Check(context.Background())
+
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status(""))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status("user"))
+	assert.Equal(t.T(), grpc_health_v1.HealthCheckResponse_NOT_SERVING, t.status("pet"))
+}
+
+type connStub struct {
+	states    []connectivity.State
+	connected bool
+}
+
+func (c *connStub) GetState() connectivity.State {
+	return c.states[0]
+}
+
+func (c *connStub) Connect() {
+	c.connected = true
+}
+
+func (c *connStub) WaitForStateChange(ctx context.Context, state connectivity.State) bool {
+	if len(c.states) == 1 {
+		<-ctx.Done()
+		return false
+	}
+	c.states = c.states[1:]
+	return true
+}
+
+func (t *HealthMonitorTest) TestConnCheckReady() {
+	conn := &connStub{states: []connectivity.State{connectivity.Ready}}
+
+	err := ConnCheck(conn)(context.Background())
+
+	assert.Nil(t.T(), err)
+	assert.False(t.T(), conn.connected)
+}
+
+func (t *HealthMonitorTest) TestConnCheckConnectsIdle() {
+	conn := &connStub{states: []connectivity.State{connectivity.Idle, connectivity.Connecting, connectivity.Ready}}
+
+	err := ConnCheck(conn)(context.Background())
+
+	assert.Nil(t.T(), err)
+	assert.True(t.T(), conn.connected)
+}
+
+func (t *HealthMonitorTest) TestConnCheckTransientFailure() {
+	conn := &connStub{states: []connectivity.State{connectivity.Connecting, connectivity.TransientFailure}}
+
+	err := ConnCheck(conn)(context.Background())
+
+	assert.NotNil(t.T(), err)
+}
+
+func (t *HealthMonitorTest) TestConnCheckTimeout() {
+	conn := &connStub{states: []connectivity.State{connectivity.Connecting}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ConnCheck(conn)(ctx)
+
+	assert.ErrorIs(t.T(), err, context.Canceled)
+}
@@ -0,0 +1,98 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	adoptionPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	petPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	authPb "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type Policy int
+
+const (
+	// AUTHENTICATED is the policy of every method missing from the policy table
+	AUTHENTICATED Policy = iota
+	PUBLIC
+	ADMIN
+)
+
+var policies = map[string]Policy{
+	grpc_health_v1.Health_Check_FullMethodName: PUBLIC,
+	grpc_health_v1.Health_Watch_FullMethodName: PUBLIC,
+
+	authPb.AuthService_SignUp_FullMethodName:       PUBLIC,
+	authPb.AuthService_SignIn_FullMethodName:       PUBLIC,
+	authPb.AuthService_RefreshToken_FullMethodName: PUBLIC,
+	authPb.AuthService_Validate_FullMethodName:     PUBLIC,
+
+	petPb.PetService_FindAll_FullMethodName:       PUBLIC,
+	petPb.PetService_FindOne_FullMethodName:       PUBLIC,
+	petPb.PetService_Create_FullMethodName:        ADMIN,
+	petPb.PetService_Update_FullMethodName:        ADMIN,
+	petPb.PetService_Delete_FullMethodName:        ADMIN,
+	petPb.PetService_ChangeView_FullMethodName:    ADMIN,
+	petPb.PetService_FindDeleted_FullMethodName:   ADMIN,
+	petPb.PetService_Restore_FullMethodName:       ADMIN,
+	petPb.PetService_FindMostLiked_FullMethodName: PUBLIC,
+
+	adoptionPb.AdoptionService_FindByPetId_FullMethodName: ADMIN,
+	adoptionPb.AdoptionService_StartReview_FullMethodName: ADMIN,
+	adoptionPb.AdoptionService_Approve_FullMethodName:     ADMIN,
+	adoptionPb.AdoptionService_Reject_FullMethodName:      ADMIN,
+	adoptionPb.AdoptionService_Complete_FullMethodName:    ADMIN,
+}
+
+type TokenValidator interface {
+	Validate(string, authConst.TokenType) (*authUtils.Identity, error)
+}
+
+// AuthUnaryInterceptor puts the identity of the caller in the context and enforces the policy of the method
+func AuthUnaryInterceptor(validator TokenValidator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		policy := policies[info.FullMethod]
+
+		identity, err := authenticate(ctx, validator)
+		if err != nil && policy != PUBLIC {
+			return nil, err
+		}
+		if identity != nil {
+			ctx = authUtils.WithIdentity(ctx, identity)
+			withCaller(ctx, identity)
+		}
+
+		if policy == ADMIN && identity.Role != userConst.ADMIN {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func authenticate(ctx context.Context, validator TokenValidator) (*authUtils.Identity, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	token, found := strings.CutPrefix(md.Get("authorization")[0], "Bearer ")
+	if !found {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	identity, err := validator.Validate(token, authConst.ACCESS_TOKEN)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,113 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bxcodec/faker/v3"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	tokenMock "github.com/isd-sgcu/johnjud-backend/src/mocks/token"
+	likePb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/like/v1"
+	petPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type AuthInterceptorTest struct {
+	suite.Suite
+	token    string
+	identity *authUtils.Identity
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	suite.Run(t, new(AuthInterceptorTest))
+}
+
+func (t *AuthInterceptorTest) SetupTest() {
+	t.token = faker.Word()
+	t.identity = &authUtils.Identity{UserId: faker.UUIDDigit(), Role: userConst.USER}
+}
+
+func (t *AuthInterceptorTest) call(ctx context.Context, validator TokenValidator, method string) (*authUtils.Identity, error) {
+	var identity *authUtils.Identity
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, _ = authUtils.IdentityFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := AuthUnaryInterceptor(validator)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return identity, err
+}
+
+func (t *AuthInterceptorTest) withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func (t *AuthInterceptorTest) TestPublicWithoutToken() {
+	identity, err := t.call(context.Background(), &tokenMock.ServiceMock{}, petPb.PetService_FindAll_FullMethodName)
+
+	assert.Nil(t.T(), err)
+	assert.Nil(t.T(), identity)
+}
+
+func (t *AuthInterceptorTest) TestPublicWithToken() {
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", t.token, authConst.ACCESS_TOKEN).Return(t.identity, nil)
+
+	identity, err := t.call(t.withToken(t.token), validator, petPb.PetService_FindOne_FullMethodName)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.identity, identity)
+}
+
+func (t *AuthInterceptorTest) TestAuthenticatedSuccess() {
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", t.token, authConst.ACCESS_TOKEN).Return(t.identity, nil)
+
+	identity, err := t.call(t.withToken(t.token), validator, likePb.LikeService_Create_FullMethodName)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.identity, identity)
+}
+
+func (t *AuthInterceptorTest) TestAuthenticatedMissingToken() {
+	_, err := t.call(context.Background(), &tokenMock.ServiceMock{}, likePb.LikeService_Create_FullMethodName)
+
+	assert.Equal(t.T(), codes.Unauthenticated, status.Code(err))
+}
+
+func (t *AuthInterceptorTest) TestAuthenticatedInvalidToken() {
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", t.token, authConst.ACCESS_TOKEN).Return(nil, errors.New("invalid token"))
+
+	_, err := t.call(t.withToken(t.token), validator, likePb.LikeService_Create_FullMethodName)
+
+	assert.Equal(t.T(), codes.Unauthenticated, status.Code(err))
+}
+
+func (t *AuthInterceptorTest) TestAdminPermissionDenied() {
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", t.token, authConst.ACCESS_TOKEN).Return(t.identity, nil)
+
+	_, err := t.call(t.withToken(t.token), validator, petPb.PetService_Create_FullMethodName)
+
+	assert.Equal(t.T(), codes.PermissionDenied, status.Code(err))
+}
+
+func (t *AuthInterceptorTest) TestAdminSuccess() {
+	t.identity.Role = userConst.ADMIN
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", t.token, authConst.ACCESS_TOKEN).Return(t.identity, nil)
+
+	identity, err := t.call(t.withToken(t.token), validator, petPb.PetService_Delete_FullMethodName)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.identity, identity)
+}
@@ -0,0 +1,181 @@
+package interceptor
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequestIDHeader is the metadata carrying the id correlating the log lines of a request
+const RequestIDHeader = "x-request-id"
+
+const redactedValue = "[REDACTED]"
+
+// defaultRedactedFields are the request fields never logged as is when LOG_REDACT is not set
+var defaultRedactedFields = []string{"password", "token", "refresh_token", "email", "contact", "address"}
+
+var requestIDPattern = regexp.MustCompile(`^[\w.:-]{1,128}$`)
+
+type requestKey struct{}
+
+type request struct {
+	id     string
+	logger *zerolog.Logger
+}
+
+// RequestID returns the id of the request handled in ctx, empty outside of a request
+func RequestID(ctx context.Context) string {
+	if r, ok := ctx.Value(requestKey{}).(*request); ok {
+		return r.id
+	}
+	return ""
+}
+
+// LoggingUnaryInterceptor logs every failed request and one of every conf.SampleEvery successful ones
+func LoggingUnaryInterceptor(conf *config.Log) grpc.UnaryServerInterceptor {
+	var handled atomic.Uint64
+	redacted := redactedFields(conf.Redact)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		id := incomingRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+
+		logger := log.With().Str("request_id", id).Str("method", info.FullMethod).Logger()
+		ctx = logger.WithContext(ctx)
+		ctx = context.WithValue(ctx, requestKey{}, &request{id: id, logger: zerolog.Ctx(ctx)})
+
+		res, err := handler(ctx, req)
+
+		code := status.Code(err)
+		if code == codes.OK && conf.SampleEvery > 1 && (handled.Add(1)-1)%uint64(conf.SampleEvery) != 0 {
+			return res, err
+		}
+
+		var event *zerolog.Event
+		switch code {
+		case codes.OK:
+			event = zerolog.Ctx(ctx).Info()
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unimplemented:
+			event = zerolog.Ctx(ctx).Error()
+		default:
+			event = zerolog.Ctx(ctx).Warn()
+		}
+
+		event = event.Str("code", code.String()).Dur("duration", time.Since(start))
+		if err != nil {
+			event = event.Str("error", status.Convert(err).Message())
+		}
+		if msg, ok := req.(proto.Message); ok && conf.Requests {
+			if body, err := protojson.Marshal(redact(msg, redacted)); err == nil {
+				event = event.RawJSON("request", body)
+			}
+		}
+		event.Msg("Handled request")
+
+		return res, err
+	}
+}
+
+// RequestIDUnaryClientInterceptor passes the id of the request on to the called service
+func RequestIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := RequestID(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// withCaller tags the logger of the request with the caller, it does nothing outside of a logged request
+func withCaller(ctx context.Context, identity *authUtils.Identity) {
+	r, ok := ctx.Value(requestKey{}).(*request)
+	if !ok {
+		return
+	}
+
+	r.logger.UpdateContext(func(c zerolog.Context) zerolog.Context {
+		return c.Str("user_id", identity.UserId).Str("role", string(identity.Role))
+	})
+}
+
+// incomingRequestID returns the request id sent by the caller, or a new one when it sent none or a malformed one
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && requestIDPattern.MatchString(values[0]) {
+			return values[0]
+		}
+	}
+	return uuid.New().String()
+}
+
+func redactedFields(value string) map[string]bool {
+	names := defaultRedactedFields
+	if value = strings.TrimSpace(value); value != "" {
+		names = strings.Split(value, ",")
+	}
+
+	fields := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+// redact returns a copy of msg with the redacted fields replaced, at any depth
+func redact(msg proto.Message, fields map[string]bool) proto.Message {
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect(), fields)
+	return clone
+}
+
+func redactMessage(m protoreflect.Message, fields map[string]bool) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fields[string(fd.Name())] || fields[fd.JSONName()]:
+			sensitive = append(sensitive, fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					redactMessage(value.Message(), fields)
+					return true
+				})
+			}
+		case fd.Message() != nil && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				redactMessage(v.List().Get(i).Message(), fields)
+			}
+		case fd.Message() != nil:
+			redactMessage(v.Message(), fields)
+		}
+		return true
+	})
+
+	// the message may only be changed once ranging over it is done
+	for _, fd := range sensitive {
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			m.Set(fd, protoreflect.ValueOfString(redactedValue))
+		} else {
+			m.Clear(fd)
+		}
+	}
+}
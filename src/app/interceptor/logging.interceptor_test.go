@@ -0,0 +1,198 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	tokenMock "github.com/isd-sgcu/johnjud-backend/src/mocks/token"
+	likePb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/like/v1"
+	petPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type LoggingInterceptorTest struct {
+	suite.Suite
+	output *bytes.Buffer
+	logger zerolog.Logger
+	conf   *config.Log
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	suite.Run(t, new(LoggingInterceptorTest))
+}
+
+func (t *LoggingInterceptorTest) SetupTest() {
+	t.output = &bytes.Buffer{}
+	t.logger = log.Logger
+	log.Logger = zerolog.New(t.output)
+	t.conf = &config.Log{}
+}
+
+func (t *LoggingInterceptorTest) TearDownTest() {
+	log.Logger = t.logger
+}
+
+func (t *LoggingInterceptorTest) call(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) error {
+	_, err := LoggingUnaryInterceptor(t.conf)(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return err
+}
+
+func (t *LoggingInterceptorTest) lines() []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(t.output.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		entry := map[string]interface{}{}
+		assert.Nil(t.T(), json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func (t *LoggingInterceptorTest) TestAssignsRequestId() {
+	var requestId string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestId = RequestID(ctx)
+		log.Ctx(ctx).Warn().Msg("from the service")
+		return nil, nil
+	}
+
+	err := t.call(context.Background(), petPb.PetService_FindAll_FullMethodName, nil, handler)
+
+	assert.Nil(t.T(), err)
+	_, err = uuid.Parse(requestId)
+	assert.Nil(t.T(), err)
+
+	lines := t.lines()
+	assert.Len(t.T(), lines, 2)
+	for _, line := range lines {
+		assert.Equal(t.T(), requestId, line["request_id"])
+		assert.Equal(t.T(), petPb.PetService_FindAll_FullMethodName, line["method"])
+	}
+	assert.Equal(t.T(), "OK", lines[1]["code"])
+	assert.Equal(t.T(), "info", lines[1]["level"])
+	assert.Contains(t.T(), lines[1], "duration")
+}
+
+func (t *LoggingInterceptorTest) TestPropagatesRequestId() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "gateway-42"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	err := t.call(ctx, petPb.PetService_FindAll_FullMethodName, nil, handler)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), "gateway-42", t.lines()[0]["request_id"])
+}
+
+func (t *LoggingInterceptorTest) TestReplacesMalformedRequestId() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "bad id\n{\"level\":\"error\"}"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	err := t.call(ctx, petPb.PetService_FindAll_FullMethodName, nil, handler)
+
+	assert.Nil(t.T(), err)
+	_, err = uuid.Parse(t.lines()[0]["request_id"].(string))
+	assert.Nil(t.T(), err)
+}
+
+func (t *LoggingInterceptorTest) TestLogsCaller() {
+	token := faker.Word()
+	identity := &authUtils.Identity{UserId: faker.UUIDDigit(), Role: userConst.USER}
+	validator := &tokenMock.ServiceMock{}
+	validator.On("Validate", token, authConst.ACCESS_TOKEN).Return(identity, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: likePb.LikeService_Create_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return AuthUnaryInterceptor(validator)(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "pet not found")
+		})
+	}
+
+	err := t.call(ctx, info.FullMethod, nil, handler)
+
+	assert.Equal(t.T(), codes.NotFound, status.Code(err))
+	line := t.lines()[0]
+	assert.Equal(t.T(), identity.UserId, line["user_id"])
+	assert.Equal(t.T(), string(userConst.USER), line["role"])
+	assert.Equal(t.T(), "NotFound", line["code"])
+	assert.Equal(t.T(), "pet not found", line["error"])
+	assert.Equal(t.T(), "warn", line["level"])
+}
+
+func (t *LoggingInterceptorTest) TestSamplesSuccessfulRequests() {
+	t.conf.SampleEvery = 3
+	interceptor := LoggingUnaryInterceptor(t.conf)
+	info := &grpc.UnaryServerInfo{FullMethod: petPb.PetService_FindAll_FullMethodName}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	failed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	for i := 0; i < 6; i++ {
+		interceptor(context.Background(), nil, info, ok)
+	}
+	interceptor(context.Background(), nil, info, failed)
+
+	lines := t.lines()
+	assert.Len(t.T(), lines, 3)
+	assert.Equal(t.T(), "Internal", lines[2]["code"])
+	assert.Equal(t.T(), "error", lines[2]["level"])
+}
+
+func (t *LoggingInterceptorTest) TestRedactsRequest() {
+	t.conf.Requests = true
+	req := &petPb.CreatePetRequest{Pet: &petPb.Pet{Name: "Tom", Contact: "0812345678", Address: "Bangkok"}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	err := t.call(context.Background(), petPb.PetService_Create_FullMethodName, req, handler)
+
+	assert.Nil(t.T(), err)
+	logged := t.lines()[0]["request"].(map[string]interface{})["Pet"].(map[string]interface{})
+	assert.Equal(t.T(), "Tom", logged["name"])
+	assert.Equal(t.T(), redactedValue, logged["contact"])
+	assert.Equal(t.T(), redactedValue, logged["address"])
+	assert.Equal(t.T(), "0812345678", req.Pet.Contact)
+}
+
+func (t *LoggingInterceptorTest) TestPassesRequestIdOn() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "gateway-42"))
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, RequestIDUnaryClientInterceptor()(ctx, "/johnjud.file.image.v1.ImageService/FindByPetId", nil, nil, nil, invoker)
+	}
+
+	err := t.call(ctx, petPb.PetService_FindOne_FullMethodName, nil, handler)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), []string{"gateway-42"}, outgoing.Get(RequestIDHeader))
+}
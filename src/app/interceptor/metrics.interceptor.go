@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MetricsObserver interface {
+	ObserveServerCall(method string, code codes.Code, duration time.Duration)
+	ObserveClientCall(method string, code codes.Code, duration time.Duration)
+}
+
+// MetricsUnaryInterceptor records the status code and latency of every request handled by the server
+func MetricsUnaryInterceptor(observer MetricsObserver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		observer.ObserveServerCall(info.FullMethod, status.Code(err), time.Since(start))
+
+		return res, err
+	}
+}
+
+// MetricsUnaryClientInterceptor records the status code and latency of every call made, retries included
+func MetricsUnaryClientInterceptor(observer MetricsObserver) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observer.ObserveClientCall(method, status.Code(err), time.Since(start))
+
+		return err
+	}
+}
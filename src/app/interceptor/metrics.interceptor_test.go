@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	petPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	imagePb "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type observation struct {
+	method string
+	code   codes.Code
+}
+
+type observerStub struct {
+	server []observation
+	client []observation
+}
+
+func (o *observerStub) ObserveServerCall(method string, code codes.Code, duration time.Duration) {
+	o.server = append(o.server, observation{method: method, code: code})
+}
+
+func (o *observerStub) ObserveClientCall(method string, code codes.Code, duration time.Duration) {
+	o.client = append(o.client, observation{method: method, code: code})
+}
+
+type MetricsInterceptorTest struct {
+	suite.Suite
+	observer *observerStub
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	suite.Run(t, new(MetricsInterceptorTest))
+}
+
+func (t *MetricsInterceptorTest) SetupTest() {
+	t.observer = &observerStub{}
+}
+
+func (t *MetricsInterceptorTest) TestServerSuccess() {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	res, err := MetricsUnaryInterceptor(t.observer)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: petPb.PetService_FindAll_FullMethodName}, handler)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), "ok", res)
+	assert.Equal(t.T(), []observation{{method: petPb.PetService_FindAll_FullMethodName, code: codes.OK}}, t.observer.server)
+}
+
+func (t *MetricsInterceptorTest) TestServerError() {
+	want := status.Error(codes.NotFound, "pet not found")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, want
+	}
+
+	_, err := MetricsUnaryInterceptor(t.observer)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: petPb.PetService_FindOne_FullMethodName}, handler)
+
+	assert.Equal(t.T(), want, err)
+	assert.Equal(t.T(), []observation{{method: petPb.PetService_FindOne_FullMethodName, code: codes.NotFound}}, t.observer.server)
+}
+
+func (t *MetricsInterceptorTest) TestClientError() {
+	want := status.Error(codes.Unavailable, "connection refused")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return want
+	}
+
+	err := MetricsUnaryClientInterceptor(t.observer)(context.Background(), imagePb.ImageService_FindByPetId_FullMethodName, nil, nil, nil, invoker)
+
+	assert.Equal(t.T(), want, err)
+	assert.Equal(t.T(), []observation{{method: imagePb.ImageService_FindByPetId_FullMethodName, code: codes.Unavailable}}, t.observer.client)
+	assert.Empty(t.T(), t.observer.server)
+}
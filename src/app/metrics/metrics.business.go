@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collectTimeout bounds the counting queries of a scrape
+const collectTimeout = 5 * time.Second
+
+type IPetRepository interface {
+	CountAdoptable(context.Context, *int64) error
+}
+
+type ILikeRepository interface {
+	CountSince(context.Context, time.Time, *int64) error
+}
+
+// BusinessCollector reports gauges about the pets and likes, counted from the database on every scrape
+type BusinessCollector struct {
+	petRepository  IPetRepository
+	likeRepository ILikeRepository
+	adoptablePets  *prometheus.Desc
+	likesPerDay    *prometheus.Desc
+}
+
+func NewBusinessCollector(petRepository IPetRepository, likeRepository ILikeRepository) *BusinessCollector {
+	return &BusinessCollector{
+		petRepository:  petRepository,
+		likeRepository: likeRepository,
+		adoptablePets: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "adoptable_pets"),
+			"Number of visible pets looking for a home.",
+			nil, nil,
+		),
+		likesPerDay: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "likes_per_day"),
+			"Number of likes given in the last 24 hours.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.adoptablePets
+	ch <- c.likesPerDay
+}
+
+// Collect reports a gauge it failed to count as an invalid metric, failing the scrape of that gauge only
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	var adoptable int64
+	if err := c.petRepository.CountAdoptable(ctx, &adoptable); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.adoptablePets, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.adoptablePets, prometheus.GaugeValue, float64(adoptable))
+	}
+
+	var likes int64
+	if err := c.likeRepository.CountSince(ctx, time.Now().Add(-24*time.Hour), &likes); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.likesPerDay, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.likesPerDay, prometheus.GaugeValue, float64(likes))
+	}
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	likeMock "github.com/isd-sgcu/johnjud-backend/src/mocks/like"
+	petMock "github.com/isd-sgcu/johnjud-backend/src/mocks/pet"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BusinessCollectorTest struct {
+	suite.Suite
+}
+
+func TestBusinessCollector(t *testing.T) {
+	suite.Run(t, new(BusinessCollectorTest))
+}
+
+func (t *BusinessCollectorTest) TestCollect() {
+	want := `
+# HELP johnjud_adoptable_pets Number of visible pets looking for a home.
+# TYPE johnjud_adoptable_pets gauge
+johnjud_adoptable_pets 12
+# HELP johnjud_likes_per_day Number of likes given in the last 24 hours.
+# TYPE johnjud_likes_per_day gauge
+johnjud_likes_per_day 34
+`
+
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("CountAdoptable").Return(int64(12), nil)
+	likeRepo := &likeMock.RepositoryMock{}
+	likeRepo.On("CountSince", mock.Anything).Return(int64(34), nil)
+
+	err := testutil.CollectAndCompare(NewBusinessCollector(petRepo, likeRepo), strings.NewReader(want))
+
+	assert.Nil(t.T(), err)
+}
+
+func (t *BusinessCollectorTest) TestCollectFailed() {
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("CountAdoptable").Return(int64(0), errors.New("connection refused"))
+	likeRepo := &likeMock.RepositoryMock{}
+	likeRepo.On("CountSince", mock.Anything).Return(int64(34), nil)
+
+	m := New()
+	m.MustRegister(NewBusinessCollector(petRepo, likeRepo))
+	res := httptest.NewRecorder()
+	m.Handler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t.T(), http.StatusOK, res.Code)
+	assert.NotContains(t.T(), res.Body.String(), "johnjud_adoptable_pets")
+	assert.Contains(t.T(), res.Body.String(), "johnjud_likes_per_day 34")
+}
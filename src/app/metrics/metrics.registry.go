@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "johnjud"
+
+// Metrics holds the collectors of the backend in a registry of its own, exposed by Handler
+type Metrics struct {
+	registry        *prometheus.Registry
+	serverHandled   *prometheus.CounterVec
+	serverDuration  *prometheus.HistogramVec
+	clientHandled   *prometheus.CounterVec
+	clientDuration  *prometheus.HistogramVec
+	dbQueryDuration *prometheus.HistogramVec
+	dbQueryErrors   *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		serverHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handled_total",
+			Help:      "Number of gRPC requests handled by the server, by method and status code.",
+		}, []string{"method", "code"}),
+		serverDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handling_seconds",
+			Help:      "Latency of the gRPC requests handled by the server, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		clientHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_client_handled_total",
+			Help:      "Number of gRPC calls made to other services such as johnjud-file, by method and status code.",
+		}, []string{"method", "code"}),
+		clientDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_client_handling_seconds",
+			Help:      "Latency of the gRPC calls made to other services such as johnjud-file, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		dbQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duration of the database queries, by operation and table.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "table"}),
+		dbQueryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "db_query_errors_total",
+			Help:      "Number of failed database queries, by operation and table. Queries finding no record are not failures.",
+		}, []string{"operation", "table"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.serverHandled,
+		m.serverDuration,
+		m.clientHandled,
+		m.clientDuration,
+		m.dbQueryDuration,
+		m.dbQueryErrors,
+	)
+
+	return m
+}
+
+// MustRegister registers more collectors, such as the business gauges, panicking on a conflict
+func (m *Metrics) MustRegister(cs ...prometheus.Collector) {
+	m.registry.MustRegister(cs...)
+}
+
+// Handler serves the metrics in the Prometheus exposition format at /metrics
+func (m *Metrics) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry:      m.registry,
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
+	return mux
+}
+
+func (m *Metrics) ObserveServerCall(method string, code codes.Code, duration time.Duration) {
+	m.serverHandled.WithLabelValues(method, code.String()).Inc()
+	m.serverDuration.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveClientCall(method string, code codes.Code, duration time.Duration) {
+	m.clientHandled.WithLabelValues(method, code.String()).Inc()
+	m.clientDuration.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveQuery(operation string, table string, duration time.Duration, failed bool) {
+	m.dbQueryDuration.WithLabelValues(operation, table).Observe(duration.Seconds())
+	if failed {
+		m.dbQueryErrors.WithLabelValues(operation, table).Inc()
+	}
+}
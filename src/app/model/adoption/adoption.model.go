@@ -0,0 +1,21 @@
+package adoption
+
+import (
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	"github.com/isd-sgcu/johnjud-backend/src/constant/adoption"
+)
+
+type Adoption struct {
+	model.Base
+	PetID      *uuid.UUID      `json:"pet_id" gorm:"index"`
+	Pet        *pet.Pet        `json:"pet" gorm:"foreignKey:PetID"`
+	UserID     *uuid.UUID      `json:"user_id" gorm:"index"`
+	User       *user.User      `json:"user" gorm:"foreignKey:UserID"`
+	Status     adoption.Status `json:"status" gorm:"tinytext" example:"submitted"`
+	Message    string          `json:"message" gorm:"mediumtext"`
+	ReviewedBy *uuid.UUID      `json:"reviewed_by"`
+	ReviewNote string          `json:"review_note" gorm:"mediumtext"`
+}
@@ -1,12 +1,26 @@
 package model
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// ErrVersionConflict is returned when a record was changed since the version the update is based on
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrInvalidCursor is returned when a cursor was not taken from a listing in the same order
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of the last item of a page, the next page starts right after that item
+type Cursor struct {
+	Sort string  `json:"s"`           // order of the listing the cursor was taken from
+	Key  *string `json:"k,omitempty"` // sort key of the item as text, nil when the item has none
+	ID   string  `json:"i"`
+}
+
 type Base struct {
 	ID        uuid.UUID      `json:"id" gorm:"primary_key"`
 	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime:nano"`
@@ -1,6 +1,8 @@
 package like
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
@@ -14,3 +16,28 @@ type Like struct {
 	UserID *uuid.UUID `json:"user_id" gorm:"index:idx_name,unique"`
 	User   *user.User `json:"user" gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL;"`
 }
+
+// PetRank is the number of likes of a pet, used to rank pets by popularity
+type PetRank struct {
+	PetID     string
+	LikeCount int64
+}
+
+type RankQuery struct {
+	Type  string
+	Since *time.Time // only likes made since, all likes when nil
+	Limit int
+}
+
+type LikesQuery struct {
+	UserID   string
+	After    *model.Cursor
+	PageSize int32 // all likes when 0
+}
+
+type LikedPetsQuery struct {
+	UserID   string
+	After    *model.Cursor
+	Page     int32 // ignored when After is set
+	PageSize int32
+}
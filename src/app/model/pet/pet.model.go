@@ -1,6 +1,8 @@
 package pet
 
 import (
+	"time"
+
 	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/constant/pet"
 )
@@ -9,7 +11,7 @@ type Pet struct {
 	model.Base
 	Type         string     `json:"type" gorm:"tinytext"`
 	Name         string     `json:"name" gorm:"tinytext"`
-	Birthdate    string     `json:"birthdate" gorm:"tinytext"`
+	Birthdate    *time.Time `json:"birthdate" gorm:"type:date"`
 	Gender       pet.Gender `json:"gender" gorm:"tinytext" example:"male"`
 	Color        string     `json:"color" gorm:"tinytext"`
 	Pattern      string     `json:"pattern" gorm:"tinytext"`
@@ -23,4 +25,29 @@ type Pet struct {
 	Address      string     `json:"address" gorm:"tinytext"`
 	Contact      string     `json:"contact" gorm:"tinytext"`
 	AdoptBy      string     `json:"adopt_by" gorm:"tinytext"`
+	Version      int64      `json:"version" gorm:"not null;default:1"`
+	PublishAt    *time.Time `json:"publish_at" gorm:"type:timestamptz"`   // the pet is made visible at this time
+	UnpublishAt  *time.Time `json:"unpublish_at" gorm:"type:timestamptz"` // the pet is hidden at this time
+}
+
+type FindAllQuery struct {
+	Search        string
+	Type          string
+	Gender        pet.Gender
+	Color         string
+	Pattern       string
+	Origin        string
+	Birthdates    []BirthdateRange // a pet matches when its birthdate falls in any of them
+	Sort          pet.Sort         // relevance for a search, newest first otherwise, when empty
+	IncludeHidden bool             // hidden pets are left out unless set
+	After         *model.Cursor
+	Page          int32 // ignored when After is set
+	PageSize      int32
+}
+
+// BirthdateRange is the birthdates of pets of an age range, of a type or of every type when Type is empty
+type BirthdateRange struct {
+	Type string
+	From *time.Time // exclusive lower bound
+	To   *time.Time // inclusive upper bound
 }
@@ -1,12 +1,15 @@
 package user
 
-import "github.com/isd-sgcu/johnjud-backend/src/app/model"
+import (
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/constant/user"
+)
 
 type User struct {
 	model.Base
-	Email     string `json:"email" gorm:"tinytext"`
-	Password  string `json:"password" gorm:"tinytext"`
-	Firstname string `json:"firstname" gorm:"tinytext"`
-	Lastname  string `json:"lastname" gorm:"tinytext"`
-	Role      string `json:"role" gorm:"tinytext"`
+	Email     string    `json:"email" gorm:"tinytext;uniqueIndex"`
+	Password  string    `json:"-" gorm:"tinytext"`
+	Firstname string    `json:"firstname" gorm:"tinytext"`
+	Lastname  string    `json:"lastname" gorm:"tinytext"`
+	Role      user.Role `json:"role" gorm:"tinytext" example:"user"`
 }
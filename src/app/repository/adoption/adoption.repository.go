@@ -0,0 +1,104 @@
+package adoption
+
+import (
+	"context"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	adoptionConst "github.com/isd-sgcu/johnjud-backend/src/constant/adoption"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) FindOne(ctx context.Context, id string, result *adoption.Adoption) error {
+	return r.db.WithContext(ctx).Model(&adoption.Adoption{}).First(result, "id = ?", id).Error
+}
+
+func (r *Repository) FindByPetId(ctx context.Context, petId string, result *[]*adoption.Adoption) error {
+	return r.db.WithContext(ctx).Model(&adoption.Adoption{}).Order("created_at").Find(result, "pet_id = ?", petId).Error
+}
+
+func (r *Repository) FindByUserId(ctx context.Context, userId string, result *[]*adoption.Adoption) error {
+	return r.db.WithContext(ctx).Model(&adoption.Adoption{}).Order("created_at").Find(result, "user_id = ?", userId).Error
+}
+
+func (r *Repository) CountByStatus(ctx context.Context, petId string, userId string, statuses []adoptionConst.Status, result *int64) error {
+	tx := r.db.WithContext(ctx).Model(&adoption.Adoption{}).Where("pet_id = ? AND status IN ?", petId, statuses)
+	if userId != "" {
+		tx = tx.Where("user_id = ?", userId)
+	}
+	return tx.Count(result).Error
+}
+
+func (r *Repository) Create(ctx context.Context, in *adoption.Adoption) error {
+	return r.db.WithContext(ctx).Create(&in).Error
+}
+
+// UpdateStatus moves the application to the status of in if it is still in one of the from statuses
+func (r *Repository) UpdateStatus(ctx context.Context, id string, from []adoptionConst.Status, in *adoption.Adoption) error {
+	res := r.db.WithContext(ctx).Model(&adoption.Adoption{}).
+		Where("id = ? AND status IN ?", id, from).
+		Updates(map[string]interface{}{
+			"status":      in.Status,
+			"reviewed_by": in.ReviewedBy,
+			"review_note": in.ReviewNote,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.FindOne(ctx, id, in)
+}
+
+// Complete completes an approved application, adopts the pet and rejects the other open applications for it
+func (r *Repository) Complete(ctx context.Context, id string, result *adoption.Adoption) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&adoption.Adoption{}).First(result, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		res := tx.Model(&adoption.Adoption{}).
+			Where("id = ? AND status = ?", id, adoptionConst.APPROVED).
+			Update("status", adoptionConst.COMPLETED)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		res = tx.Model(&pet.Pet{}).
+			Where("id = ? AND status = ?", result.PetID, petConst.FINDHOME).
+			Updates(map[string]interface{}{
+				"status":   petConst.ADOPTED,
+				"adopt_by": result.UserID.String(),
+				"version":  gorm.Expr("version + 1"),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		err := tx.Model(&adoption.Adoption{}).
+			Where("pet_id = ? AND id <> ? AND status IN ?", result.PetID, id, adoptionConst.OPEN).
+			Update("status", adoptionConst.REJECTED).Error
+		if err != nil {
+			return err
+		}
+
+		result.Status = adoptionConst.COMPLETED
+		return nil
+	})
+}
@@ -1,7 +1,13 @@
 package like
 
 import (
+	"context"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
 	"gorm.io/gorm"
 )
 
@@ -13,14 +19,155 @@ func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) FindByUserId(userId string, result *[]*like.Like) error {
-	return r.db.Model(&like.Like{}).Find(result, "user_id = ?", userId).Error
+func (r *Repository) FindOne(ctx context.Context, id string, result *like.Like) error {
+	return r.db.WithContext(ctx).Model(&like.Like{}).First(result, "id = ?", id).Error
+}
+
+// likedAt orders the likes of a user, most recent first
+var likedAt = dbUtils.SortKey{Name: "liked_at", Expr: "likes.created_at", Type: "timestamp", Desc: true, ID: "likes.id"}
+
+// FindByUserId finds the likes of the user, most recent first, a page after query.After when it has a page size
+func (r *Repository) FindByUserId(ctx context.Context, query *like.LikesQuery, result *[]*like.Like, next *model.Cursor) error {
+	tx := r.db.WithContext(ctx).Model(&like.Like{}).Where("likes.user_id = ?", query.UserID).Scopes(likedAt.After(query.After), likedAt.Order)
+	if query.PageSize > 0 {
+		tx = tx.Limit(int(query.PageSize) + 1)
+	}
+
+	if err := tx.Find(result).Error; err != nil {
+		return err
+	}
+
+	if query.PageSize > 0 && len(*result) > int(query.PageSize) {
+		*result = (*result)[:query.PageSize]
+		return likedAt.Cursor(r.db.WithContext(ctx).Model(&like.Like{}), (*result)[query.PageSize-1].ID.String(), next)
+	}
+	return nil
+}
+
+// FindLikedPets finds a page of the likes of the user with their visible pets, most recent first
+func (r *Repository) FindLikedPets(ctx context.Context, query *like.LikedPetsQuery, result *[]*like.Like, total *int64, next *model.Cursor) error {
+	liked := func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&like.Like{}).
+			Joins("JOIN pets ON pets.id = likes.pet_id AND pets.deleted_at IS NULL").
+			Where("likes.user_id = ? AND pets.is_visible", query.UserID)
+	}
+
+	if err := r.db.WithContext(ctx).Scopes(liked).Count(total).Error; err != nil {
+		return err
+	}
+
+	page := query.Page
+	if query.After != nil {
+		page = 1
+	}
+	paginate, _ := dbUtils.Page(page, query.PageSize, 1)
+
+	tx := r.db.WithContext(ctx).Scopes(liked, likedAt.After(query.After), likedAt.Order, paginate).Preload("Pet")
+	if err := tx.Find(result).Error; err != nil {
+		return err
+	}
+
+	if len(*result) > int(query.PageSize) {
+		*result = (*result)[:query.PageSize]
+		return likedAt.Cursor(r.db.WithContext(ctx).Model(&like.Like{}), (*result)[query.PageSize-1].ID.String(), next)
+	}
+	return nil
+}
+
+func (r *Repository) FindByPetAndUser(ctx context.Context, petId string, userId string, result *like.Like) error {
+	return r.db.WithContext(ctx).Model(&like.Like{}).First(result, "pet_id = ? AND user_id = ?", petId, userId).Error
+}
+
+// CountSince counts the likes given since the given time
+func (r *Repository) CountSince(ctx context.Context, since time.Time, result *int64) error {
+	return r.db.WithContext(ctx).Model(&like.Like{}).Where("created_at >= ?", since).Count(result).Error
+}
+
+// CountByPetIds sets the number of likes of every given pet that has any
+func (r *Repository) CountByPetIds(ctx context.Context, petIds []string, result map[string]int64) error {
+	var rows []struct {
+		PetID string
+		Count int64
+	}
+
+	err := r.db.WithContext(ctx).Model(&like.Like{}).
+		Select("pet_id, count(*) AS count").
+		Where("pet_id IN ?", petIds).
+		Group("pet_id").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		result[row.PetID] = row.Count
+	}
+	return nil
+}
+
+// FindLikedPetIds finds which of the given pets the user likes
+func (r *Repository) FindLikedPetIds(ctx context.Context, userId string, petIds []string, result *[]string) error {
+	return r.db.WithContext(ctx).Model(&like.Like{}).
+		Where("user_id = ? AND pet_id IN ?", userId, petIds).
+		Pluck("pet_id", result).Error
+}
+
+// RankPets ranks the adoptable pets by their number of likes, most liked first. Pets without likes are not ranked.
+func (r *Repository) RankPets(ctx context.Context, query *like.RankQuery, result *[]*like.PetRank) error {
+	tx := r.db.WithContext(ctx).Model(&like.Like{}).
+		Select("likes.pet_id, count(*) AS like_count").
+		Joins("JOIN pets ON pets.id = likes.pet_id AND pets.deleted_at IS NULL").
+		Where("pets.status = ? AND pets.is_visible", petConst.FINDHOME)
+	if query.Since != nil {
+		tx = tx.Where("likes.created_at >= ?", *query.Since)
+	}
+	if query.Type != "" {
+		tx = tx.Where("pets.type = ?", query.Type)
+	}
+
+	return tx.Group("likes.pet_id").
+		Order("like_count DESC, likes.pet_id").
+		Limit(query.Limit).
+		Scan(result).Error
+}
+
+// FindCoLikedPets ranks the adoptable pets liked by the users who like the same pets as the user
+func (r *Repository) FindCoLikedPets(ctx context.Context, userId string, limit int, result *[]*like.PetRank) error {
+	return r.db.WithContext(ctx).Raw(`
+		SELECT other.pet_id, count(*) AS like_count
+		FROM likes mine
+		JOIN likes peer ON peer.pet_id = mine.pet_id AND peer.user_id <> mine.user_id AND peer.deleted_at IS NULL
+		JOIN likes other ON other.user_id = peer.user_id AND other.deleted_at IS NULL
+		JOIN pets ON pets.id = other.pet_id AND pets.deleted_at IS NULL
+		WHERE mine.user_id = ? AND mine.deleted_at IS NULL
+			AND pets.status = ? AND pets.is_visible
+			AND other.pet_id NOT IN (SELECT pet_id FROM likes WHERE user_id = ? AND deleted_at IS NULL)
+		GROUP BY other.pet_id
+		ORDER BY like_count DESC, other.pet_id
+		LIMIT ?`, userId, petConst.FINDHOME, userId, limit).
+		Scan(result).Error
+}
+
+func (r *Repository) Create(ctx context.Context, in *like.Like) error {
+	return r.db.WithContext(ctx).Create(&in).Error
+}
+
+// Delete permanently removes the like so the pet can be liked again, gorm.ErrRecordNotFound when there is none
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	return r.delete(r.db.WithContext(ctx).Where("id = ?", id))
 }
 
-func (r *Repository) Create(in *like.Like) error {
-	return r.db.Create(&in).Error
+func (r *Repository) DeleteByPetAndUser(ctx context.Context, petId string, userId string) error {
+	return r.delete(r.db.WithContext(ctx).Where("pet_id = ? AND user_id = ?", petId, userId))
 }
 
-func (r *Repository) Delete(id string) error {
-	return r.db.Where("id = ?", id).Delete(&like.Like{}).Error
+func (r *Repository) delete(tx *gorm.DB) error {
+	res := tx.Unscoped().Delete(&like.Like{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
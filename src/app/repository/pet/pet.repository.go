@@ -1,10 +1,45 @@
 package pet
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// a search matches whole words, names close to it, or anywhere in the text as Thai words are not separated
+const (
+	searchExpr = "(search_vector @@ websearch_to_tsquery('simple', @search) OR name % @search" +
+		" OR name ILIKE @pattern OR caption ILIKE @pattern OR habit ILIKE @pattern)"
+	searchRankExpr = "(ts_rank(search_vector, websearch_to_tsquery('simple', ?)) + similarity(name, ?))"
 )
 
+// sortKeys are the orders of the listings, a search is ordered by relevance unless told otherwise
+var sortKeys = map[petConst.Sort]dbUtils.SortKey{
+	petConst.NEWEST:     {Expr: "created_at", Type: "timestamp", Desc: true},
+	petConst.OLDEST:     {Expr: "created_at", Type: "timestamp"},
+	petConst.YOUNGEST:   {Expr: "birthdate", Type: "date", Desc: true},
+	petConst.ELDEST:     {Expr: "birthdate", Type: "date"},
+	petConst.NAME:       {Expr: "lower(name)", Type: "text"},
+	petConst.MOST_LIKED: {Expr: "(SELECT count(*) FROM likes WHERE likes.pet_id = pets.id)", Type: "bigint", Desc: true},
+	petConst.RELEVANCE:  {Expr: searchRankExpr, Type: "real", Desc: true},
+}
+
+// key of the advisory lock held while the schedule is applied, so only one replica applies it
+const scheduleLockKey = 7210035
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type Repository struct {
 	db *gorm.DB
 }
@@ -13,22 +48,292 @@ func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) FindAll(result *[]*pet.Pet) error {
-	return r.db.Model(&pet.Pet{}).Find(result).Error
+// FindAll finds a page of the pets matching the query after query.After and counts all of them
+func (r *Repository) FindAll(ctx context.Context, query *pet.FindAllQuery, result *[]*pet.Pet, total *int64, next *model.Cursor) error {
+	if err := r.db.WithContext(ctx).Model(&pet.Pet{}).Scopes(filter(query)).Count(total).Error; err != nil {
+		return err
+	}
+
+	page := query.Page
+	if query.After != nil {
+		page = 1
+	}
+	paginate, ok := dbUtils.Page(page, query.PageSize, 1)
+	if !ok {
+		*result = []*pet.Pet{}
+		return nil
+	}
+
+	key := sortKey(query)
+	tx := r.db.WithContext(ctx).Model(&pet.Pet{}).Scopes(filter(query), key.After(query.After), key.Order, paginate)
+	if err := tx.Find(result).Error; err != nil {
+		return err
+	}
+
+	if query.PageSize > 0 && len(*result) > int(query.PageSize) {
+		*result = (*result)[:query.PageSize]
+		return key.Cursor(r.db.WithContext(ctx).Model(&pet.Pet{}), (*result)[query.PageSize-1].ID.String(), next)
+	}
+	return nil
+}
+
+func (r *Repository) FindOne(ctx context.Context, id string, result *pet.Pet) error {
+	return r.db.WithContext(ctx).Model(&pet.Pet{}).First(result, "id = ?", id).Error
+}
+
+func (r *Repository) FindByIds(ctx context.Context, ids []string, result *[]*pet.Pet) error {
+	return r.db.WithContext(ctx).Model(&pet.Pet{}).Find(result, "id IN ?", ids).Error
+}
+
+// FindAdoptable finds the latest visible pets looking for a home
+func (r *Repository) FindAdoptable(ctx context.Context, limit int, result *[]*pet.Pet) error {
+	return r.db.WithContext(ctx).Model(&pet.Pet{}).
+		Where("status = ? AND is_visible", petConst.FINDHOME).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(result).Error
+}
+
+// CountAdoptable counts the visible pets looking for a home
+func (r *Repository) CountAdoptable(ctx context.Context, result *int64) error {
+	return r.db.WithContext(ctx).Model(&pet.Pet{}).
+		Where("status = ? AND is_visible", petConst.FINDHOME).
+		Count(result).Error
+}
+
+func (r *Repository) Create(ctx context.Context, in *pet.Pet) error {
+	return r.db.WithContext(ctx).Create(&in).Error
+}
+
+// Update writes the given columns of in and bumps the version, model.ErrVersionConflict when in.Version is stale
+func (r *Repository) Update(ctx context.Context, id string, columns []string, in *pet.Pet) error {
+	if len(columns) > 0 {
+		values, err := r.columnValues(columns, in)
+		if err != nil {
+			return err
+		}
+		values["version"] = gorm.Expr("version + 1")
+
+		tx := r.db.WithContext(ctx).Model(&pet.Pet{}).Where("id = ?", id)
+		if in.Version > 0 {
+			tx = tx.Where("version = ?", in.Version)
+		}
+
+		res := tx.Updates(values)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return r.missingOrConflict(ctx, id)
+		}
+	}
+
+	return r.db.WithContext(ctx).First(in, "id = ?", id).Error
+}
+
+// Delete returns gorm.ErrRecordNotFound when there is nothing to delete
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	res := r.db.WithContext(ctx).Where("id = ?", id).Delete(&pet.Pet{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindDeleted finds the soft-deleted pets, most recently deleted first
+func (r *Repository) FindDeleted(ctx context.Context, page int32, pageSize int32, result *[]*pet.Pet, total *int64) error {
+	tx := r.db.WithContext(ctx).Unscoped().Model(&pet.Pet{}).Where("deleted_at IS NOT NULL")
+	if err := tx.Count(total).Error; err != nil {
+		return err
+	}
+
+	paginate, ok := dbUtils.Page(page, pageSize, 0)
+	if !ok {
+		*result = []*pet.Pet{}
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Unscoped().Model(&pet.Pet{}).Where("deleted_at IS NOT NULL").Order("deleted_at DESC").Scopes(paginate).Find(result).Error
+}
+
+// FindPurgeable finds the pets soft-deleted before the given time
+func (r *Repository) FindPurgeable(ctx context.Context, before time.Time, result *[]*pet.Pet) error {
+	return r.db.WithContext(ctx).Unscoped().Model(&pet.Pet{}).Find(result, "deleted_at < ?", before).Error
+}
+
+// Restore undeletes a soft-deleted pet, it returns gorm.ErrRecordNotFound when no such pet is deleted
+func (r *Repository) Restore(ctx context.Context, id string) error {
+	res := r.db.WithContext(ctx).Unscoped().Model(&pet.Pet{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Purge permanently removes a soft-deleted pet along with its likes and adoption applications
+func (r *Repository) Purge(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("pet_id = ?", id).Delete(&like.Like{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("pet_id = ?", id).Delete(&adoption.Adoption{}).Error; err != nil {
+			return err
+		}
+
+		res := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&pet.Pet{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// ApplySchedule publishes and unpublishes the pets that are due, unless another replica is applying the schedule
+func (r *Repository) ApplySchedule(ctx context.Context, now time.Time, published *[]*pet.Pet, unpublished *[]*pet.Pet) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", scheduleLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		err := tx.Model(published).Clauses(clause.Returning{}).
+			Where("publish_at <= ?", now).
+			Updates(map[string]interface{}{
+				"is_visible": true,
+				"publish_at": nil,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(unpublished).Clauses(clause.Returning{}).
+			Where("unpublish_at <= ?", now).
+			Updates(map[string]interface{}{
+				"is_visible":   false,
+				"unpublish_at": nil,
+				"version":      gorm.Expr("version + 1"),
+			}).Error
+	})
+}
+
+func (r *Repository) columnValues(columns []string, in *pet.Pet) (map[string]interface{}, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(in); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(columns)+1)
+	for _, column := range columns {
+		field := stmt.Schema.LookUpField(column)
+		if field == nil {
+			return nil, fmt.Errorf("unknown pet column %s", column)
+		}
+		values[column], _ = field.ValueOf(context.Background(), reflect.ValueOf(in).Elem())
+	}
+
+	return values, nil
 }
 
-func (r *Repository) FindOne(id string, result *pet.Pet) error {
-	return r.db.Model(&pet.Pet{}).First(result, "id = ?", id).Error
+// missingOrConflict tells why a conditional update of the pet matched no row
+func (r *Repository) missingOrConflict(ctx context.Context, id string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&pet.Pet{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return model.ErrVersionConflict
 }
 
-func (r *Repository) Create(in *pet.Pet) error {
-	return r.db.Create(&in).Error
+func filter(query *pet.FindAllQuery) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if query.Search != "" {
+			tx = tx.Where(searchExpr, map[string]interface{}{
+				"search":  query.Search,
+				"pattern": "%" + likeEscaper.Replace(query.Search) + "%",
+			})
+		}
+		if query.Type != "" {
+			tx = tx.Where("type = ?", query.Type)
+		}
+		if query.Gender != "" {
+			tx = tx.Where("gender = ?", query.Gender)
+		}
+		if query.Color != "" {
+			tx = tx.Where("color = ?", query.Color)
+		}
+		if query.Pattern != "" {
+			tx = tx.Where("pattern = ?", query.Pattern)
+		}
+		if query.Origin != "" {
+			tx = tx.Where("origin = ?", query.Origin)
+		}
+		if len(query.Birthdates) > 0 {
+			tx = tx.Where(birthdates(tx, query.Birthdates))
+		}
+		if !query.IncludeHidden {
+			tx = tx.Where("is_visible")
+		}
+		return tx
+	}
 }
 
-func (r *Repository) Update(id string, result *pet.Pet) error {
-	return r.db.Where(id, "id = ?", id).Updates(&result).First(&result, "id = ?", id).Error
+// birthdates matches the pets born in any of the ranges
+func birthdates(tx *gorm.DB, ranges []pet.BirthdateRange) *gorm.DB {
+	result := tx.Session(&gorm.Session{NewDB: true})
+	for _, r := range ranges {
+		cond := tx.Session(&gorm.Session{NewDB: true}).Where("birthdate IS NOT NULL")
+		if r.Type != "" {
+			cond = cond.Where("lower(type) = ?", r.Type)
+		}
+		if r.From != nil {
+			cond = cond.Where("birthdate > ?", *r.From)
+		}
+		if r.To != nil {
+			cond = cond.Where("birthdate <= ?", *r.To)
+		}
+		result = result.Or(cond)
+	}
+	return result
 }
 
-func (r *Repository) Delete(id string) error {
-	return r.db.Where("id = ?", id).Delete(&pet.Pet{}).Error
+func sortKey(query *pet.FindAllQuery) dbUtils.SortKey {
+	sort := query.Sort
+	if sort == "" && query.Search != "" {
+		sort = petConst.RELEVANCE
+	}
+	if sort == petConst.RELEVANCE && query.Search == "" {
+		sort = petConst.NEWEST
+	}
+
+	key, ok := sortKeys[sort]
+	if !ok {
+		sort, key = petConst.NEWEST, sortKeys[petConst.NEWEST]
+	}
+	key.Name = string(sort)
+	key.ID = "id"
+	if sort == petConst.RELEVANCE {
+		key.Vars = []interface{}{query.Search, query.Search}
+	}
+
+	return key
 }
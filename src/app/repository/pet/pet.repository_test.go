@@ -0,0 +1,69 @@
+package pet
+
+import (
+	"testing"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type PetRepositoryTest struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func TestPetRepository(t *testing.T) {
+	suite.Run(t, new(PetRepositoryTest))
+}
+
+func (t *PetRepositoryTest) SetupTest() {
+	// a dry run builds the statements without a database
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.Nil(t.T(), err)
+	t.db = db
+}
+
+func (t *PetRepositoryTest) findAll(query *pet.FindAllQuery) *gorm.Statement {
+	var result []*pet.Pet
+	return t.db.Model(&pet.Pet{}).Scopes(filter(query), sortKey(query).Order).Find(&result).Statement
+}
+
+func (t *PetRepositoryTest) TestSearch() {
+	stmt := t.findAll(&pet.FindAllQuery{Search: "milo"})
+
+	assert.Equal(t.T(), `SELECT * FROM "pets" WHERE `+
+		`((search_vector @@ websearch_to_tsquery('simple', $1) OR name % $2`+
+		` OR name ILIKE $3 OR caption ILIKE $4 OR habit ILIKE $5))`+
+		` AND is_visible AND "pets"."deleted_at" IS NULL`+
+		` ORDER BY (ts_rank(search_vector, websearch_to_tsquery('simple', $6)) + similarity(name, $7)) DESC NULLS LAST, id`,
+		stmt.SQL.String())
+	assert.Equal(t.T(), []interface{}{"milo", "milo", "%milo%", "%milo%", "%milo%", "milo", "milo"}, stmt.Vars)
+}
+
+func (t *PetRepositoryTest) TestSearchEscapesPattern() {
+	stmt := t.findAll(&pet.FindAllQuery{Search: `50%_off\`})
+
+	search, pattern := `50%_off\`, `%50\%\_off\\%`
+	assert.Equal(t.T(), []interface{}{search, search, pattern, pattern, pattern, search, search}, stmt.Vars)
+}
+
+func (t *PetRepositoryTest) TestSearchWithSort() {
+	stmt := t.findAll(&pet.FindAllQuery{Search: "milo", Sort: petConst.NAME})
+
+	assert.Contains(t.T(), stmt.SQL.String(), "websearch_to_tsquery('simple', $1)")
+	assert.Contains(t.T(), stmt.SQL.String(), "ORDER BY lower(name) NULLS LAST, id")
+	assert.NotContains(t.T(), stmt.SQL.String(), "ts_rank")
+	assert.Len(t.T(), stmt.Vars, 5)
+}
+
+func (t *PetRepositoryTest) TestRelevanceWithoutSearch() {
+	stmt := t.findAll(&pet.FindAllQuery{Sort: petConst.RELEVANCE})
+
+	assert.Equal(t.T(), `SELECT * FROM "pets" WHERE is_visible AND "pets"."deleted_at" IS NULL`+
+		` ORDER BY created_at DESC NULLS LAST, id`, stmt.SQL.String())
+	assert.Empty(t.T(), stmt.Vars)
+}
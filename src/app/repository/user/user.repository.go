@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) FindOne(ctx context.Context, id string, result *user.User) error {
+	return r.db.WithContext(ctx).Model(&user.User{}).First(result, "id = ?", id).Error
+}
+
+func (r *Repository) FindByEmail(ctx context.Context, email string, result *user.User) error {
+	return r.db.WithContext(ctx).Model(&user.User{}).First(result, "email = ?", email).Error
+}
+
+func (r *Repository) Create(ctx context.Context, in *user.User) error {
+	return r.db.WithContext(ctx).Create(&in).Error
+}
+
+func (r *Repository) Update(ctx context.Context, id string, result *user.User) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Updates(&result).First(&result, "id = ?", id).Error
+}
+
+// Delete returns gorm.ErrRecordNotFound when there is nothing to delete
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	res := r.db.WithContext(ctx).Where("id = ?", id).Delete(&user.User{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package adoption
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	adoptionUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/adoption"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
+	adoptionConst "github.com/isd-sgcu/johnjud-backend/src/constant/adoption"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	proto.UnimplementedAdoptionServiceServer
+	repository     IRepository
+	petRepository  IPetRepository
+	userRepository IUserRepository
+}
+
+type IRepository interface {
+	FindOne(context.Context, string, *adoption.Adoption) error
+	FindByPetId(context.Context, string, *[]*adoption.Adoption) error
+	FindByUserId(context.Context, string, *[]*adoption.Adoption) error
+	CountByStatus(context.Context, string, string, []adoptionConst.Status, *int64) error
+	Create(context.Context, *adoption.Adoption) error
+	UpdateStatus(context.Context, string, []adoptionConst.Status, *adoption.Adoption) error
+	Complete(context.Context, string, *adoption.Adoption) error
+}
+
+type IPetRepository interface {
+	FindOne(context.Context, string, *pet.Pet) error
+}
+
+type IUserRepository interface {
+	FindOne(context.Context, string, *user.User) error
+}
+
+func NewService(repository IRepository, petRepository IPetRepository, userRepository IUserRepository) *Service {
+	return &Service{repository: repository, petRepository: petRepository, userRepository: userRepository}
+}
+
+// Submit creates a new application of the user for the pet
+func (s *Service) Submit(ctx context.Context, req *proto.SubmitAdoptionRequest) (*proto.SubmitAdoptionResponse, error) {
+	if !authUtils.CanActAs(ctx, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	petUUID, err := uuid.Parse(req.PetId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pet id")
+	}
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	raw := pet.Pet{}
+	err = s.petRepository.FindOne(ctx, req.PetId, &raw)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "pet")
+	}
+	if !petUtils.CanInteract(ctx, &raw) {
+		return nil, status.Error(codes.NotFound, "pet not found")
+	}
+	if raw.Status != petConst.FINDHOME {
+		return nil, status.Error(codes.FailedPrecondition, "pet is already adopted")
+	}
+
+	err = s.userRepository.FindOne(ctx, req.UserId, &user.User{})
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	var open int64
+	err = s.repository.CountByStatus(ctx, req.PetId, req.UserId, adoptionConst.OPEN, &open)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+	if open > 0 {
+		return nil, status.Error(codes.AlreadyExists, "user already applied to adopt this pet")
+	}
+
+	in := &adoption.Adoption{
+		PetID:   &petUUID,
+		UserID:  &userUUID,
+		Status:  adoptionConst.SUBMITTED,
+		Message: req.Message,
+	}
+
+	err = s.repository.Create(ctx, in)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	return &proto.SubmitAdoptionResponse{Adoption: adoptionUtils.RawToDto(in)}, nil
+}
+
+func (s *Service) FindOne(ctx context.Context, req *proto.FindOneAdoptionRequest) (*proto.FindOneAdoptionResponse, error) {
+	raw := adoption.Adoption{}
+
+	err := s.repository.FindOne(ctx, req.Id, &raw)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	if raw.UserID == nil || !authUtils.CanActAs(ctx, raw.UserID.String()) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return &proto.FindOneAdoptionResponse{Adoption: adoptionUtils.RawToDto(&raw)}, nil
+}
+
+func (s *Service) FindByPetId(ctx context.Context, req *proto.FindAdoptionByPetIdRequest) (*proto.FindAdoptionByPetIdResponse, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	var result []*adoption.Adoption
+	err := s.repository.FindByPetId(ctx, req.PetId, &result)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	return &proto.FindAdoptionByPetIdResponse{Adoptions: adoptionUtils.RawToDtoList(result)}, nil
+}
+
+func (s *Service) FindByUserId(ctx context.Context, req *proto.FindAdoptionByUserIdRequest) (*proto.FindAdoptionByUserIdResponse, error) {
+	if !authUtils.CanActAs(ctx, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	var result []*adoption.Adoption
+	err := s.repository.FindByUserId(ctx, req.UserId, &result)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	return &proto.FindAdoptionByUserIdResponse{Adoptions: adoptionUtils.RawToDtoList(result)}, nil
+}
+
+func (s *Service) StartReview(ctx context.Context, req *proto.ReviewAdoptionRequest) (*proto.ReviewAdoptionResponse, error) {
+	return s.review(ctx, req, adoptionConst.UNDER_REVIEW)
+}
+
+// Approve approves the application, only one application can be approved per pet
+func (s *Service) Approve(ctx context.Context, req *proto.ReviewAdoptionRequest) (*proto.ReviewAdoptionResponse, error) {
+	return s.review(ctx, req, adoptionConst.APPROVED)
+}
+
+func (s *Service) Reject(ctx context.Context, req *proto.ReviewAdoptionRequest) (*proto.ReviewAdoptionResponse, error) {
+	return s.review(ctx, req, adoptionConst.REJECTED)
+}
+
+// Complete completes an approved application and marks the pet as adopted
+func (s *Service) Complete(ctx context.Context, req *proto.CompleteAdoptionRequest) (*proto.CompleteAdoptionResponse, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	raw := adoption.Adoption{}
+	err := s.repository.Complete(ctx, req.Id, &raw)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "adoption is not approved or pet is already adopted")
+		}
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	return &proto.CompleteAdoptionResponse{Adoption: adoptionUtils.RawToDto(&raw)}, nil
+}
+
+func (s *Service) review(ctx context.Context, req *proto.ReviewAdoptionRequest, to adoptionConst.Status) (*proto.ReviewAdoptionResponse, error) {
+	identity, ok := authUtils.IdentityFromContext(ctx)
+	if !ok || !authUtils.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	reviewerId, err := uuid.Parse(identity.UserId)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	raw := adoption.Adoption{}
+	err = s.repository.FindOne(ctx, req.Id, &raw)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	if !canTransition(raw.Status, to) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot move adoption from %v to %v", raw.Status, to)
+	}
+
+	if to == adoptionConst.APPROVED {
+		var approved int64
+		err = s.repository.CountByStatus(ctx, raw.PetID.String(), "", []adoptionConst.Status{adoptionConst.APPROVED, adoptionConst.COMPLETED}, &approved)
+		if err != nil {
+			return nil, dbUtils.StatusError(ctx, err, "adoption")
+		}
+		if approved > 0 {
+			return nil, status.Error(codes.FailedPrecondition, "another adoption of this pet is already approved")
+		}
+	}
+
+	in := &adoption.Adoption{
+		Status:     to,
+		ReviewedBy: &reviewerId,
+		ReviewNote: req.Note,
+	}
+
+	err = s.repository.UpdateStatus(ctx, req.Id, []adoptionConst.Status{raw.Status}, in)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Aborted, "adoption was changed concurrently")
+		}
+		if dbUtils.IsDuplicate(err) {
+			// another adoption of the pet was approved since the count above
+			return nil, status.Error(codes.FailedPrecondition, "another adoption of this pet is already approved")
+		}
+		return nil, dbUtils.StatusError(ctx, err, "adoption")
+	}
+
+	return &proto.ReviewAdoptionResponse{Adoption: adoptionUtils.RawToDto(in)}, nil
+}
+
+func canTransition(from adoptionConst.Status, to adoptionConst.Status) bool {
+	for _, s := range adoptionConst.Transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
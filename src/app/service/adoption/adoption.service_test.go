@@ -0,0 +1,334 @@
+package adoption
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	adoptionUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/adoption"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	adoptionConst "github.com/isd-sgcu/johnjud-backend/src/constant/adoption"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/adoption"
+	petMock "github.com/isd-sgcu/johnjud-backend/src/mocks/pet"
+	userMock "github.com/isd-sgcu/johnjud-backend/src/mocks/user"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	tMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type AdoptionServiceTest struct {
+	suite.Suite
+	Pet      *pet.Pet
+	User     *user.User
+	Adoption *adoption.Adoption
+	userCtx  context.Context
+	adminCtx context.Context
+	adminId  uuid.UUID
+}
+
+func TestAdoptionService(t *testing.T) {
+	suite.Run(t, new(AdoptionServiceTest))
+}
+
+func (t *AdoptionServiceTest) SetupTest() {
+	t.Pet = &pet.Pet{
+		Base:      model.Base{ID: uuid.New()},
+		Name:      faker.Name(),
+		Status:    petConst.FINDHOME,
+		IsVisible: true,
+	}
+	t.User = &user.User{
+		Base: model.Base{ID: uuid.New()},
+		Role: userConst.USER,
+	}
+	t.Adoption = &adoption.Adoption{
+		Base:    model.Base{ID: uuid.New()},
+		PetID:   &t.Pet.ID,
+		UserID:  &t.User.ID,
+		Status:  adoptionConst.SUBMITTED,
+		Message: faker.Sentence(),
+	}
+	t.adminId = uuid.New()
+
+	t.userCtx = authUtils.WithIdentity(context.Background(), &authUtils.Identity{UserId: t.User.ID.String(), Role: userConst.USER})
+	t.adminCtx = authUtils.WithIdentity(context.Background(), &authUtils.Identity{UserId: t.adminId.String(), Role: userConst.ADMIN})
+}
+
+func (t *AdoptionServiceTest) TestSubmitSuccess() {
+	repo := &mock.RepositoryMock{}
+	repo.On("CountByStatus", t.Pet.ID.String(), t.User.ID.String(), adoptionConst.OPEN).Return(int64(0), nil)
+	repo.On("Create", tMock.MatchedBy(func(in *adoption.Adoption) bool {
+		return *in.PetID == t.Pet.ID && *in.UserID == t.User.ID && in.Status == adoptionConst.SUBMITTED
+	})).Return(t.Adoption, nil)
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	userRepo := &userMock.RepositoryMock{}
+	userRepo.On("FindOne", t.User.ID.String(), &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo, petRepo, userRepo)
+	actual, err := srv.Submit(t.userCtx, &proto.SubmitAdoptionRequest{PetId: t.Pet.ID.String(), UserId: t.User.ID.String(), Message: t.Adoption.Message})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.SubmitAdoptionResponse{Adoption: adoptionUtils.RawToDto(t.Adoption)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestSubmitHiddenPet() {
+	t.Pet.IsVisible = false
+
+	repo := &mock.RepositoryMock{}
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+
+	srv := NewService(repo, petRepo, &userMock.RepositoryMock{})
+	actual, err := srv.Submit(t.userCtx, &proto.SubmitAdoptionRequest{PetId: t.Pet.ID.String(), UserId: t.User.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, status.Code(err))
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *AdoptionServiceTest) TestSubmitPetAlreadyAdopted() {
+	t.Pet.Status = petConst.ADOPTED
+
+	repo := &mock.RepositoryMock{}
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+
+	srv := NewService(repo, petRepo, &userMock.RepositoryMock{})
+	actual, err := srv.Submit(t.userCtx, &proto.SubmitAdoptionRequest{PetId: t.Pet.ID.String(), UserId: t.User.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, status.Code(err))
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *AdoptionServiceTest) TestSubmitAlreadyApplied() {
+	repo := &mock.RepositoryMock{}
+	repo.On("CountByStatus", t.Pet.ID.String(), t.User.ID.String(), adoptionConst.OPEN).Return(int64(1), nil)
+	petRepo := &petMock.RepositoryMock{}
+	petRepo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	userRepo := &userMock.RepositoryMock{}
+	userRepo.On("FindOne", t.User.ID.String(), &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo, petRepo, userRepo)
+	actual, err := srv.Submit(t.userCtx, &proto.SubmitAdoptionRequest{PetId: t.Pet.ID.String(), UserId: t.User.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, status.Code(err))
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *AdoptionServiceTest) TestSubmitPermissionDenied() {
+	srv := NewService(&mock.RepositoryMock{}, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Submit(t.userCtx, &proto.SubmitAdoptionRequest{PetId: t.Pet.ID.String(), UserId: uuid.NewString()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestFindOneSuccess() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.FindOne(t.userCtx, &proto.FindOneAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.FindOneAdoptionResponse{Adoption: adoptionUtils.RawToDto(t.Adoption)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestFindOneNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.FindOne(t.userCtx, &proto.FindOneAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestFindOneInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(nil, errors.New("something wrong"))
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.FindOne(t.userCtx, &proto.FindOneAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestApproveSuccess() {
+	t.Adoption.Status = adoptionConst.UNDER_REVIEW
+	want := &adoption.Adoption{
+		Base:       t.Adoption.Base,
+		PetID:      t.Adoption.PetID,
+		UserID:     t.Adoption.UserID,
+		Status:     adoptionConst.APPROVED,
+		ReviewedBy: &t.adminId,
+	}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+	repo.On("CountByStatus", t.Pet.ID.String(), "", []adoptionConst.Status{adoptionConst.APPROVED, adoptionConst.COMPLETED}).Return(int64(0), nil)
+	repo.On("UpdateStatus", t.Adoption.ID.String(), []adoptionConst.Status{adoptionConst.UNDER_REVIEW}, &adoption.Adoption{
+		Status:     adoptionConst.APPROVED,
+		ReviewedBy: &t.adminId,
+	}).Return(want, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Approve(t.adminCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.ReviewAdoptionResponse{Adoption: adoptionUtils.RawToDto(want)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestApproveAnotherAlreadyApproved() {
+	t.Adoption.Status = adoptionConst.UNDER_REVIEW
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+	repo.On("CountByStatus", t.Pet.ID.String(), "", []adoptionConst.Status{adoptionConst.APPROVED, adoptionConst.COMPLETED}).Return(int64(1), nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Approve(t.adminCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, status.Code(err))
+	repo.AssertNotCalled(t.T(), "UpdateStatus", tMock.Anything, tMock.Anything, tMock.Anything)
+}
+
+func (t *AdoptionServiceTest) TestApproveApprovedConcurrently() {
+	t.Adoption.Status = adoptionConst.UNDER_REVIEW
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+	repo.On("CountByStatus", t.Pet.ID.String(), "", []adoptionConst.Status{adoptionConst.APPROVED, adoptionConst.COMPLETED}).Return(int64(0), nil)
+	repo.On("UpdateStatus", t.Adoption.ID.String(), []adoptionConst.Status{adoptionConst.UNDER_REVIEW}, tMock.Anything).Return(nil, &pgconn.PgError{Code: "23505"})
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Approve(t.adminCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestApproveIllegalTransition() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Approve(t.adminCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestReviewPermissionDenied() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.StartReview(t.userCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, status.Code(err))
+	repo.AssertNotCalled(t.T(), "FindOne", tMock.Anything, tMock.Anything)
+}
+
+func (t *AdoptionServiceTest) TestCompleteSuccess() {
+	t.Adoption.Status = adoptionConst.COMPLETED
+
+	repo := &mock.RepositoryMock{}
+	repo.On("Complete", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Complete(t.adminCtx, &proto.CompleteAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.CompleteAdoptionResponse{Adoption: adoptionUtils.RawToDto(t.Adoption)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestCompleteNotApproved() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Complete", t.Adoption.ID.String(), &adoption.Adoption{}).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Complete(t.adminCtx, &proto.CompleteAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestCompleteInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Complete", t.Adoption.ID.String(), &adoption.Adoption{}).Return(nil, errors.New("something wrong"))
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Complete(t.adminCtx, &proto.CompleteAdoptionRequest{Id: t.Adoption.ID.String()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, status.Code(err))
+}
+
+func (t *AdoptionServiceTest) TestRejectWithNote() {
+	want := &adoption.Adoption{
+		Base:       t.Adoption.Base,
+		PetID:      t.Adoption.PetID,
+		UserID:     t.Adoption.UserID,
+		Status:     adoptionConst.REJECTED,
+		ReviewedBy: &t.adminId,
+		ReviewNote: "no garden",
+	}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Adoption.ID.String(), &adoption.Adoption{}).Return(t.Adoption, nil)
+	repo.On("UpdateStatus", t.Adoption.ID.String(), []adoptionConst.Status{adoptionConst.SUBMITTED}, &adoption.Adoption{
+		Status:     adoptionConst.REJECTED,
+		ReviewedBy: &t.adminId,
+		ReviewNote: "no garden",
+	}).Return(want, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.Reject(t.adminCtx, &proto.ReviewAdoptionRequest{Id: t.Adoption.ID.String(), Note: "no garden"})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.ReviewAdoptionResponse{Adoption: adoptionUtils.RawToDto(want)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestFindByUserIdSuccess() {
+	adoptions := []*adoption.Adoption{t.Adoption}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByUserId", t.User.ID.String(), []*adoption.Adoption(nil)).Return(&adoptions, nil)
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.FindByUserId(t.userCtx, &proto.FindAdoptionByUserIdRequest{UserId: t.User.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.FindAdoptionByUserIdResponse{Adoptions: adoptionUtils.RawToDtoList(adoptions)}, actual)
+}
+
+func (t *AdoptionServiceTest) TestFindByUserIdPermissionDenied() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, &petMock.RepositoryMock{}, &userMock.RepositoryMock{})
+	actual, err := srv.FindByUserId(t.userCtx, &proto.FindAdoptionByUserIdRequest{UserId: uuid.NewString()})
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, status.Code(err))
+	repo.AssertNotCalled(t.T(), "FindByUserId", tMock.Anything, tMock.Anything)
+}
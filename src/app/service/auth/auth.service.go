@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	userUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/user"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidCredential = status.Error(codes.Unauthenticated, "invalid email or password")
+
+type Service struct {
+	proto.UnimplementedAuthServiceServer
+	userRepository IUserRepository
+	tokenService   ITokenService
+}
+
+type IUserRepository interface {
+	FindOne(context.Context, string, *user.User) error
+	FindByEmail(context.Context, string, *user.User) error
+	Create(context.Context, *user.User) error
+}
+
+type ITokenService interface {
+	CreateCredential(*user.User) (*proto.Credential, error)
+	Validate(string, authConst.TokenType) (*authUtils.Identity, error)
+}
+
+func NewService(userRepository IUserRepository, tokenService ITokenService) *Service {
+	return &Service{userRepository: userRepository, tokenService: tokenService}
+}
+
+func (s *Service) SignUp(ctx context.Context, req *proto.SignUpRequest) (*proto.SignUpResponse, error) {
+	email := userUtils.NormalizeEmail(req.Email)
+	if email == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+
+	existing := user.User{}
+	err := s.userRepository.FindByEmail(ctx, email, &existing)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "email is already in use")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	hash, err := userUtils.HashPassword(req.Password)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("service", "auth").Str("module", "sign up").Msg("Error while hashing password")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	raw := &user.User{
+		Email:     email,
+		Password:  hash,
+		Firstname: req.FirstName,
+		Lastname:  req.LastName,
+		Role:      userConst.USER,
+	}
+
+	err = s.userRepository.Create(ctx, raw)
+	if dbUtils.IsDuplicate(err) {
+		// signed up concurrently since the lookup above
+		return nil, status.Error(codes.AlreadyExists, "email is already in use")
+	}
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	return &proto.SignUpResponse{
+		Id:        raw.ID.String(),
+		FirstName: raw.Firstname,
+		LastName:  raw.Lastname,
+		Email:     raw.Email,
+	}, nil
+}
+
+func (s *Service) SignIn(ctx context.Context, req *proto.SignInRequest) (*proto.SignInResponse, error) {
+	raw, err := s.Authenticate(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.tokenService.CreateCredential(raw)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("service", "auth").Str("module", "sign in").Msg("Error while creating credential")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &proto.SignInResponse{Credential: credential}, nil
+}
+
+func (s *Service) Validate(_ context.Context, req *proto.ValidateRequest) (*proto.ValidateResponse, error) {
+	identity, err := s.tokenService.Validate(req.Token, authConst.ACCESS_TOKEN)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return &proto.ValidateResponse{UserId: identity.UserId, Role: string(identity.Role)}, nil
+}
+
+func (s *Service) RefreshToken(ctx context.Context, req *proto.RefreshTokenRequest) (*proto.RefreshTokenResponse, error) {
+	identity, err := s.tokenService.Validate(req.RefreshToken, authConst.REFRESH_TOKEN)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	// the role is read again so that role changes apply from the next refresh
+	raw := user.User{}
+	err = s.userRepository.FindOne(ctx, identity.UserId, &raw)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	credential, err := s.tokenService.CreateCredential(&raw)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("service", "auth").Str("module", "refresh token").Msg("Error while creating credential")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &proto.RefreshTokenResponse{Credential: credential}, nil
+}
+
+// Authenticate returns the user owning the email when the password matches its hash
+func (s *Service) Authenticate(ctx context.Context, email string, password string) (*user.User, error) {
+	raw := user.User{}
+
+	err := s.userRepository.FindByEmail(ctx, userUtils.NormalizeEmail(email), &raw)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredential
+		}
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	if !userUtils.ComparePassword(raw.Password, password) {
+		return nil, ErrInvalidCredential
+	}
+
+	return &raw, nil
+}
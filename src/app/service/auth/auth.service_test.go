@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	userUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/user"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	tokenMock "github.com/isd-sgcu/johnjud-backend/src/mocks/token"
+	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+	"github.com/stretchr/testify/assert"
+	tMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type AuthServiceTest struct {
+	suite.Suite
+	User       *user.User
+	Password   string
+	SignUpReq  *proto.SignUpRequest
+	Credential *proto.Credential
+	Identity   *authUtils.Identity
+}
+
+func TestAuthService(t *testing.T) {
+	suite.Run(t, new(AuthServiceTest))
+}
+
+func (t *AuthServiceTest) SetupTest() {
+	t.Password = faker.Password()
+	hash, _ := userUtils.HashPassword(t.Password)
+
+	t.User = &user.User{
+		Base: model.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Time{},
+			UpdatedAt: time.Time{},
+			DeletedAt: gorm.DeletedAt{},
+		},
+		Email:     strings.ToLower(faker.Email()),
+		Password:  hash,
+		Firstname: faker.FirstName(),
+		Lastname:  faker.LastName(),
+		Role:      userConst.USER,
+	}
+
+	t.Credential = &proto.Credential{
+		AccessToken:  faker.Word(),
+		RefreshToken: faker.Word(),
+		ExpiresIn:    900,
+	}
+
+	t.Identity = &authUtils.Identity{
+		UserId: t.User.ID.String(),
+		Role:   t.User.Role,
+	}
+
+	t.SignUpReq = &proto.SignUpRequest{
+		FirstName: t.User.Firstname,
+		LastName:  t.User.Lastname,
+		Email:     t.User.Email,
+		Password:  t.Password,
+	}
+}
+
+func (t *AuthServiceTest) TestSignUpSuccess() {
+	want := &proto.SignUpResponse{
+		Id:        t.User.ID.String(),
+		FirstName: t.User.Firstname,
+		LastName:  t.User.Lastname,
+		Email:     t.User.Email,
+	}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Create", tMock.MatchedBy(func(in *user.User) bool {
+		return in.Email == t.User.Email && in.Role == userConst.USER && userUtils.ComparePassword(in.Password, t.Password)
+	})).Return(t.User, nil)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.SignUp(context.Background(), t.SignUpReq)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *AuthServiceTest) TestSignUpDuplicateEmail() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.SignUp(context.Background(), t.SignUpReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, st.Code())
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *AuthServiceTest) TestSignUpDuplicateEmailOnCreate() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Create", tMock.Anything).Return(nil, gorm.ErrDuplicatedKey)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.SignUp(context.Background(), t.SignUpReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, st.Code())
+}
+
+func (t *AuthServiceTest) TestSignUpInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Create", tMock.Anything).Return(nil, errors.New("something wrong"))
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.SignUp(context.Background(), t.SignUpReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, st.Code())
+}
+
+func (t *AuthServiceTest) TestAuthenticateSuccess() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.Authenticate(context.Background(), t.User.Email, t.Password)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.User, actual)
+}
+
+func (t *AuthServiceTest) TestAuthenticateWrongPassword() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.Authenticate(context.Background(), t.User.Email, "wrong"+t.Password)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrInvalidCredential, err)
+}
+
+func (t *AuthServiceTest) TestAuthenticateUnknownEmail() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo, &tokenMock.ServiceMock{})
+	actual, err := srv.Authenticate(context.Background(), t.User.Email, t.Password)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrInvalidCredential, err)
+}
+
+func (t *AuthServiceTest) TestSignInSuccess() {
+	want := &proto.SignInResponse{Credential: t.Credential}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+	tokenSrv := &tokenMock.ServiceMock{}
+	tokenSrv.On("CreateCredential", t.User).Return(t.Credential, nil)
+
+	srv := NewService(repo, tokenSrv)
+	actual, err := srv.SignIn(context.Background(), &proto.SignInRequest{Email: t.User.Email, Password: t.Password})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *AuthServiceTest) TestSignInInvalidCredential() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+	tokenSrv := &tokenMock.ServiceMock{}
+
+	srv := NewService(repo, tokenSrv)
+	actual, err := srv.SignIn(context.Background(), &proto.SignInRequest{Email: t.User.Email, Password: "wrong" + t.Password})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Unauthenticated, st.Code())
+	tokenSrv.AssertNotCalled(t.T(), "CreateCredential", tMock.Anything)
+}
+
+func (t *AuthServiceTest) TestValidateSuccess() {
+	want := &proto.ValidateResponse{UserId: t.User.ID.String(), Role: string(t.User.Role)}
+
+	tokenSrv := &tokenMock.ServiceMock{}
+	tokenSrv.On("Validate", t.Credential.AccessToken, authConst.ACCESS_TOKEN).Return(t.Identity, nil)
+
+	srv := NewService(&mock.RepositoryMock{}, tokenSrv)
+	actual, err := srv.Validate(context.Background(), &proto.ValidateRequest{Token: t.Credential.AccessToken})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *AuthServiceTest) TestRefreshTokenSuccess() {
+	want := &proto.RefreshTokenResponse{Credential: t.Credential}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.User.ID.String(), &user.User{}).Return(t.User, nil)
+	tokenSrv := &tokenMock.ServiceMock{}
+	tokenSrv.On("Validate", t.Credential.RefreshToken, authConst.REFRESH_TOKEN).Return(t.Identity, nil)
+	tokenSrv.On("CreateCredential", t.User).Return(t.Credential, nil)
+
+	srv := NewService(repo, tokenSrv)
+	actual, err := srv.RefreshToken(context.Background(), &proto.RefreshTokenRequest{RefreshToken: t.Credential.RefreshToken})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *AuthServiceTest) TestRefreshTokenInvalidToken() {
+	tokenSrv := &tokenMock.ServiceMock{}
+	tokenSrv.On("Validate", t.Credential.AccessToken, authConst.REFRESH_TOKEN).Return(nil, errors.New("invalid token"))
+
+	srv := NewService(&mock.RepositoryMock{}, tokenSrv)
+	actual, err := srv.RefreshToken(context.Background(), &proto.RefreshTokenRequest{RefreshToken: t.Credential.AccessToken})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Unauthenticated, st.Code())
+}
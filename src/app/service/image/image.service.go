@@ -2,12 +2,15 @@ package image
 
 import (
 	"context"
-	"time"
+	"sync"
 
 	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
 	"github.com/rs/zerolog/log"
 )
 
+// maximum number of concurrent FindByPetId calls made by FindByPetIds
+const findByPetIdsWorkers = 8
+
 type Service struct {
 	client proto.ImageServiceClient
 }
@@ -16,13 +19,10 @@ func NewService(client proto.ImageServiceClient) *Service {
 	return &Service{client: client}
 }
 
-func (s *Service) FindByPetId(petId string) ([]*proto.Image, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
+func (s *Service) FindByPetId(ctx context.Context, petId string) ([]*proto.Image, error) {
 	res, err := s.client.FindByPetId(ctx, &proto.FindImageByPetIdRequest{PetId: petId})
 	if err != nil {
-		log.Error().
+		log.Ctx(ctx).Error().
 			Err(err).
 			Str("service", "image").
 			Str("module", "find by petId").
@@ -32,3 +32,79 @@ func (s *Service) FindByPetId(petId string) ([]*proto.Image, error) {
 	return res.Images, nil
 
 }
+
+// Evict drops the images of the pet cached by the client, if it caches any
+func (s *Service) Evict(petId string) {
+	if c, ok := s.client.(interface{ Evict(string) }); ok {
+		c.Evict(petId)
+	}
+}
+
+// DeleteByPetId deletes every image of the pet, stopping at the first image that fails to be deleted
+func (s *Service) DeleteByPetId(ctx context.Context, petId string) error {
+	images, err := s.FindByPetId(ctx, petId)
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		_, err := s.client.Delete(ctx, &proto.DeleteImageRequest{Id: image.Id})
+		if err != nil {
+			log.Ctx(ctx).Error().
+				Err(err).
+				Str("service", "image").
+				Str("module", "delete by petId").
+				Str("pet_id", petId).
+				Str("image_id", image.Id).
+				Msg("Error while deleting image")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPetIds fetches the images of the pets concurrently, leaving out the pets whose lookup fails
+func (s *Service) FindByPetIds(ctx context.Context, petIds []string) map[string][]*proto.Image {
+	result := make(map[string][]*proto.Image, len(petIds))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+
+	workers := findByPetIdsWorkers
+	if len(petIds) < workers {
+		workers = len(petIds)
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for petId := range jobs {
+				images, err := s.FindByPetId(ctx, petId)
+				if err != nil {
+					log.Ctx(ctx).Warn().
+						Err(err).
+						Str("service", "image").
+						Str("module", "find by petIds").
+						Str("pet_id", petId).
+						Msg("Skipping images of pet")
+					continue
+				}
+
+				mu.Lock()
+				result[petId] = images
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, petId := range petIds {
+		jobs <- petId
+	}
+	close(jobs)
+	wg.Wait()
+
+	return result
+}
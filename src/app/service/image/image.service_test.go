@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"testing"
 
 	"github.com/bxcodec/faker/v3"
@@ -47,7 +48,7 @@ func (t *ImageServiceTest) TestFindByPetIdSuccess() {
 		Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
 
 	srv := NewService(&c)
-	actual, err := srv.FindByPetId(t.petId)
+	actual, err := srv.FindByPetId(context.Background(), t.petId)
 
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
@@ -59,10 +60,85 @@ func (t *ImageServiceTest) TestFindByPetIdError() {
 		Return(&proto.FindImageByPetIdResponse{Images: t.images}, status.Error(codes.Unavailable, "Connection Timeout"))
 
 	srv := NewService(&c)
-	actual, err := srv.FindByPetId(t.petId)
+	actual, err := srv.FindByPetId(context.Background(), t.petId)
 
 	st, ok := status.FromError(err)
 	assert.True(t.T(), ok)
 	assert.Nil(t.T(), actual)
 	assert.Equal(t.T(), codes.Unavailable, st.Code())
 }
+
+func (t *ImageServiceTest) TestFindByPetIdsSuccess() {
+	otherPetId := faker.UUIDDigit()
+	otherImages := []*proto.Image{
+		{
+			Id:       faker.UUIDDigit(),
+			PetId:    otherPetId,
+			ImageUrl: faker.URL(),
+		},
+	}
+	want := map[string][]*proto.Image{
+		t.petId:    t.images,
+		otherPetId: otherImages,
+	}
+
+	c := mock.ClientMock{}
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: t.petId}).
+		Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: otherPetId}).
+		Return(&proto.FindImageByPetIdResponse{Images: otherImages}, nil)
+
+	srv := NewService(&c)
+	actual := srv.FindByPetIds(context.Background(), []string{t.petId, otherPetId})
+
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *ImageServiceTest) TestFindByPetIdsPartialError() {
+	failedPetId := faker.UUIDDigit()
+	want := map[string][]*proto.Image{
+		t.petId: t.images,
+	}
+
+	c := mock.ClientMock{}
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: t.petId}).
+		Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: failedPetId}).
+		Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	srv := NewService(&c)
+	actual := srv.FindByPetIds(context.Background(), []string{t.petId, failedPetId})
+
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *ImageServiceTest) TestDeleteByPetIdSuccess() {
+	c := mock.ClientMock{}
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: t.petId}).
+		Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+	for _, image := range t.images {
+		c.On("Delete", &proto.DeleteImageRequest{Id: image.Id}).Return(&proto.DeleteImageResponse{Success: true}, nil)
+	}
+
+	srv := NewService(&c)
+	err := srv.DeleteByPetId(context.Background(), t.petId)
+
+	assert.Nil(t.T(), err)
+	c.AssertNumberOfCalls(t.T(), "Delete", len(t.images))
+}
+
+func (t *ImageServiceTest) TestDeleteByPetIdError() {
+	c := mock.ClientMock{}
+	c.On("FindByPetId", &proto.FindImageByPetIdRequest{PetId: t.petId}).
+		Return(&proto.FindImageByPetIdResponse{Images: t.images}, nil)
+	c.On("Delete", &proto.DeleteImageRequest{Id: t.images[0].Id}).
+		Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	srv := NewService(&c)
+	err := srv.DeleteByPetId(context.Background(), t.petId)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), codes.Unavailable, st.Code())
+	c.AssertNumberOfCalls(t.T(), "Delete", 1)
+}
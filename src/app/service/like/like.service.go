@@ -2,68 +2,221 @@ package like
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
-	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/like/v1"
-	"github.com/rs/zerolog/log"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/like/v1"
+	petProto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	imageProto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
+// page size of FindLikedPets when none is given
+const defaultLikedPetsPageSize = 20
+
 type Service struct {
 	proto.UnimplementedLikeServiceServer
-	repository IRepository
+	repository     IRepository
+	userRepository IUserRepository
+	petRepository  IPetRepository
+	imageService   ImageService
 }
 
 type IRepository interface {
-	FindByUserId(string, *[]*like.Like) error
-	Create(*like.Like) error
-	Delete(string) error
+	FindOne(context.Context, string, *like.Like) error
+	FindByUserId(context.Context, *like.LikesQuery, *[]*like.Like, *model.Cursor) error
+	FindByPetAndUser(context.Context, string, string, *like.Like) error
+	FindLikedPets(context.Context, *like.LikedPetsQuery, *[]*like.Like, *int64, *model.Cursor) error
+	Create(context.Context, *like.Like) error
+	Delete(context.Context, string) error
+	DeleteByPetAndUser(context.Context, string, string) error
+}
+
+type IUserRepository interface {
+	FindOne(context.Context, string, *user.User) error
+}
+
+type IPetRepository interface {
+	FindOne(context.Context, string, *pet.Pet) error
+}
+
+type ImageService interface {
+	FindByPetIds(ctx context.Context, petIds []string) map[string][]*imageProto.Image
 }
 
-func NewService(repository IRepository) *Service {
+func NewService(repository IRepository, userRepository IUserRepository, petRepository IPetRepository, imageService ImageService) *Service {
 	return &Service{
-		repository: repository,
+		repository:     repository,
+		userRepository: userRepository,
+		petRepository:  petRepository,
+		imageService:   imageService,
 	}
 }
 
-func (s *Service) FindByUserId(_ context.Context, req *proto.FindLikeByUserIdRequest) (res *proto.FindLikeByUserIdResponse, err error) {
+// FindByUserId lists the likes of the user, most recent first, a page at a time when given a page size
+func (s *Service) FindByUserId(ctx context.Context, req *proto.FindLikeByUserIdRequest) (res *proto.FindLikeByUserIdResponse, err error) {
+	if !authUtils.CanActAs(ctx, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+
+	query := &like.LikesQuery{UserID: req.UserId, PageSize: req.PageSize}
+	query.After, err = petUtils.PageCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	var likes []*like.Like
+	var next model.Cursor
+	err = s.repository.FindByUserId(ctx, query, &likes, &next)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	return &proto.FindLikeByUserIdResponse{Likes: RawToDtoList(&likes), NextCursor: petUtils.NextCursor(&next)}, nil
+}
+
+// FindLikedPets lists a page of the visible pets the user likes, most recently liked first
+func (s *Service) FindLikedPets(ctx context.Context, req *proto.FindLikedPetsRequest) (*petProto.FindAllPetResponse, error) {
+	userId := req.UserId
+	if !authUtils.CanActAs(ctx, userId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLikedPetsPageSize
+	}
 
-	err = s.repository.FindByUserId(req.UserId, &likes)
+	after, err := petUtils.PageCursor(req.Cursor)
 	if err != nil {
+		return nil, err
+	}
 
-		log.Error().Err(err).
-			Str("service", "like").
-			Str("module", "find by userId").
-			Str("useId", req.UserId).
-			Msg("Not found")
+	var likes []*like.Like
+	var total int64
+	var next model.Cursor
+	query := &like.LikedPetsQuery{UserID: userId, After: after, Page: page, PageSize: pageSize}
+	err = s.repository.FindLikedPets(ctx, query, &likes, &total, &next)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	pets := make([]*pet.Pet, 0, len(likes))
+	petIds := make([]string, 0, len(likes))
+	for _, l := range likes {
+		if l.Pet == nil {
+			continue
+		}
+		pets = append(pets, l.Pet)
+		petIds = append(petIds, l.Pet.ID.String())
+	}
+	images := s.imageService.FindByPetIds(ctx, petIds)
 
-		return nil, status.Error(codes.NotFound, err.Error())
+	metaData := petProto.FindAllPetMetaData{}
+	petUtils.PaginationMetaData(total, page, pageSize, &metaData)
+	if after != nil {
+		metaData.Page = 0
 	}
+	metaData.NextCursor = petUtils.NextCursor(&next)
 
-	return &proto.FindLikeByUserIdResponse{Likes: RawToDtoList(&likes)}, nil
+	return &petProto.FindAllPetResponse{Pets: petUtils.RawToDtoList(&pets, images), Metadata: &metaData}, nil
 }
 
-func (s *Service) Create(_ context.Context, req *proto.CreateLikeRequest) (res *proto.CreateLikeResponse, err error) {
-	raw, _ := DtoToRaw(req.Like)
+// Create likes the pet, liking a pet that is already liked returns the existing like
+func (s *Service) Create(ctx context.Context, req *proto.CreateLikeRequest) (res *proto.CreateLikeResponse, err error) {
+	if req.Like == nil {
+		return nil, status.Error(codes.InvalidArgument, "like is required")
+	}
+	if !authUtils.CanActAs(ctx, req.Like.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	raw, err := DtoToRaw(req.Like)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pet or user id")
+	}
+
+	err = s.userRepository.FindOne(ctx, req.Like.UserId, &user.User{})
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	liked := pet.Pet{}
+	err = s.petRepository.FindOne(ctx, req.Like.PetId, &liked)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "pet")
+	}
+	if !petUtils.CanInteract(ctx, &liked) {
+		return nil, status.Error(codes.NotFound, "pet not found")
+	}
+
+	existing := like.Like{}
+	err = s.repository.FindByPetAndUser(ctx, req.Like.PetId, req.Like.UserId, &existing)
+	if err == nil {
+		return &proto.CreateLikeResponse{Like: RawToDto(&existing)}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
 
-	err = s.repository.Create(raw)
+	err = s.repository.Create(ctx, raw)
+	if dbUtils.IsDuplicate(err) {
+		// liked concurrently since the lookup above
+		raw = &like.Like{}
+		err = s.repository.FindByPetAndUser(ctx, req.Like.PetId, req.Like.UserId, raw)
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create like")
+		return nil, dbUtils.StatusError(ctx, err, "like")
 	}
 
 	return &proto.CreateLikeResponse{Like: RawToDto(raw)}, nil
 }
 
-func (s *Service) Delete(_ context.Context, req *proto.DeleteLikeRequest) (res *proto.DeleteLikeResponse, err error) {
-	err = s.repository.Delete(req.Id)
+func (s *Service) Delete(ctx context.Context, req *proto.DeleteLikeRequest) (res *proto.DeleteLikeResponse, err error) {
+	raw := like.Like{}
+	err = s.repository.FindOne(ctx, req.Id, &raw)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	if raw.UserID == nil || !authUtils.CanActAs(ctx, raw.UserID.String()) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	err = s.repository.Delete(ctx, req.Id)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	return &proto.DeleteLikeResponse{Success: true}, nil
+}
+
+// DeleteByPetAndUser unlikes the pet for the user
+func (s *Service) DeleteByPetAndUser(ctx context.Context, req *proto.DeleteLikeByPetAndUserRequest) (*proto.DeleteLikeResponse, error) {
+	if !authUtils.CanActAs(ctx, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	err := s.repository.DeleteByPetAndUser(ctx, req.PetId, req.UserId)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "something wrong when deleting like")
+		return nil, dbUtils.StatusError(ctx, err, "like")
 	}
 
 	return &proto.DeleteLikeResponse{Success: true}, nil
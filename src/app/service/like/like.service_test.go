@@ -0,0 +1,357 @@
+package like
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	imageMock "github.com/isd-sgcu/johnjud-backend/src/mocks/image"
+	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/like"
+	petMock "github.com/isd-sgcu/johnjud-backend/src/mocks/pet"
+	userMock "github.com/isd-sgcu/johnjud-backend/src/mocks/user"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/like/v1"
+	petProto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	imageProto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	tMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type LikeServiceTest struct {
+	suite.Suite
+	Like       *like.Like
+	LikeDto    *proto.Like
+	userId     string
+	petId      string
+	ctx        context.Context
+	userRepo   *userMock.RepositoryMock
+	petRepo    *petMock.RepositoryMock
+	createReq  *proto.CreateLikeRequest
+	createWant *proto.CreateLikeResponse
+}
+
+func TestLikeService(t *testing.T) {
+	suite.Run(t, new(LikeServiceTest))
+}
+
+func (t *LikeServiceTest) SetupTest() {
+	userId := uuid.New()
+	petId := uuid.New()
+	t.userId = userId.String()
+	t.petId = petId.String()
+
+	t.Like = &like.Like{
+		Base: model.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Time{},
+			UpdatedAt: time.Time{},
+			DeletedAt: gorm.DeletedAt{},
+		},
+		PetID:  &petId,
+		UserID: &userId,
+	}
+
+	t.LikeDto = &proto.Like{
+		Id:     t.Like.ID.String(),
+		PetId:  t.petId,
+		UserId: t.userId,
+	}
+
+	t.ctx = authUtils.WithIdentity(context.Background(), &authUtils.Identity{
+		UserId: t.userId,
+		Role:   userConst.USER,
+	})
+
+	t.userRepo = new(userMock.RepositoryMock)
+	t.userRepo.On("FindOne", t.userId, &user.User{}).Return(&user.User{}, nil)
+	t.petRepo = new(petMock.RepositoryMock)
+	t.petRepo.On("FindOne", t.petId, &pet.Pet{}).Return(&pet.Pet{IsVisible: true}, nil)
+
+	t.createReq = &proto.CreateLikeRequest{Like: &proto.Like{PetId: t.petId, UserId: t.userId}}
+	t.createWant = &proto.CreateLikeResponse{Like: t.LikeDto}
+}
+
+func (t *LikeServiceTest) TestCreateSuccess() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindByPetAndUser", t.petId, t.userId).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Create", tMock.Anything).Return(t.Like, nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.createWant, actual)
+}
+
+func (t *LikeServiceTest) TestCreateAlreadyLiked() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindByPetAndUser", t.petId, t.userId).Return(t.Like, nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.createWant, actual)
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *LikeServiceTest) TestCreateLikedConcurrently() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindByPetAndUser", t.petId, t.userId).Return(nil, gorm.ErrRecordNotFound).Once()
+	repo.On("Create", tMock.Anything).Return(nil, &pgconn.PgError{Code: "23505"})
+	repo.On("FindByPetAndUser", t.petId, t.userId).Return(t.Like, nil).Once()
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.createWant, actual)
+}
+
+func (t *LikeServiceTest) TestCreatePetNotFound() {
+	petRepo := new(petMock.RepositoryMock)
+	petRepo.On("FindOne", t.petId, &pet.Pet{}).Return(nil, gorm.ErrRecordNotFound)
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *LikeServiceTest) TestCreateHiddenPet() {
+	petRepo := new(petMock.RepositoryMock)
+	petRepo.On("FindOne", t.petId, &pet.Pet{}).Return(&pet.Pet{IsVisible: false}, nil)
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *LikeServiceTest) TestCreateInvalidPetId() {
+	t.createReq.Like.PetId = "abc"
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+}
+
+func (t *LikeServiceTest) TestCreatePermissionDenied() {
+	t.createReq.Like.UserId = uuid.NewString()
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Create(t.ctx, t.createReq)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+}
+
+func (t *LikeServiceTest) TestDeleteSuccess() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindOne", t.Like.ID.String(), &like.Like{}).Return(t.Like, nil)
+	repo.On("Delete", t.Like.ID.String()).Return(nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Delete(t.ctx, &proto.DeleteLikeRequest{Id: t.Like.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.DeleteLikeResponse{Success: true}, actual)
+}
+
+func (t *LikeServiceTest) TestDeleteNotFound() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindOne", t.Like.ID.String(), &like.Like{}).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.Delete(t.ctx, &proto.DeleteLikeRequest{Id: t.Like.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *LikeServiceTest) TestDeleteByPetAndUserSuccess() {
+	repo := new(mock.RepositoryMock)
+	repo.On("DeleteByPetAndUser", t.petId, t.userId).Return(nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.DeleteByPetAndUser(t.ctx, &proto.DeleteLikeByPetAndUserRequest{PetId: t.petId, UserId: t.userId})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.DeleteLikeResponse{Success: true}, actual)
+}
+
+func (t *LikeServiceTest) TestDeleteByPetAndUserNotFound() {
+	repo := new(mock.RepositoryMock)
+	repo.On("DeleteByPetAndUser", t.petId, t.userId).Return(gorm.ErrRecordNotFound)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.DeleteByPetAndUser(t.ctx, &proto.DeleteLikeByPetAndUserRequest{PetId: t.petId, UserId: t.userId})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *LikeServiceTest) TestDeleteByPetAndUserPermissionDenied() {
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.DeleteByPetAndUser(t.ctx, &proto.DeleteLikeByPetAndUserRequest{PetId: t.petId, UserId: uuid.NewString()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+	repo.AssertNotCalled(t.T(), "DeleteByPetAndUser", tMock.Anything, tMock.Anything)
+}
+
+func (t *LikeServiceTest) TestFindByUserIdSuccess() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindByUserId", &like.LikesQuery{UserID: t.userId}).Return(&[]*like.Like{t.Like}, nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.FindByUserId(t.ctx, &proto.FindLikeByUserIdRequest{UserId: t.userId})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.FindLikeByUserIdResponse{Likes: []*proto.Like{t.LikeDto}}, actual)
+}
+
+func (t *LikeServiceTest) TestFindByUserIdAfterCursor() {
+	after := &model.Cursor{Sort: "liked_at", ID: uuid.NewString()}
+
+	repo := new(mock.RepositoryMock)
+	repo.On("FindByUserId", &like.LikesQuery{UserID: t.userId, After: after, PageSize: 10}).Return(&[]*like.Like{t.Like}, nil)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.FindByUserId(t.ctx, &proto.FindLikeByUserIdRequest{UserId: t.userId, PageSize: 10, Cursor: petUtils.EncodeCursor(after)})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.FindLikeByUserIdResponse{Likes: []*proto.Like{t.LikeDto}}, actual)
+}
+
+func (t *LikeServiceTest) TestFindByUserIdInvalidPageSize() {
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.FindByUserId(t.ctx, &proto.FindLikeByUserIdRequest{UserId: t.userId, PageSize: -1})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "FindByUserId", tMock.Anything)
+}
+
+func (t *LikeServiceTest) TestFindByUserIdPermissionDenied() {
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.FindByUserId(t.ctx, &proto.FindLikeByUserIdRequest{UserId: uuid.NewString()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+}
+
+func (t *LikeServiceTest) TestFindLikedPetsSuccess() {
+	t.Like.Pet = &pet.Pet{
+		Base:      model.Base{ID: *t.Like.PetID},
+		Name:      faker.Name(),
+		Status:    petConst.ADOPTED,
+		IsVisible: true,
+	}
+	images := []*imageProto.Image{{Id: uuid.NewString(), PetId: t.petId, ImageUrl: faker.URL()}}
+
+	repo := new(mock.RepositoryMock)
+	repo.On("FindLikedPets", &like.LikedPetsQuery{UserID: t.userId, Page: 1, PageSize: defaultLikedPetsPageSize}).Return(&[]*like.Like{t.Like}, int64(1), nil)
+	imageSrv := new(imageMock.ServiceMock)
+	imageSrv.On("FindByPetIds", []string{t.petId}).Return(map[string][]*imageProto.Image{t.petId: images})
+
+	srv := NewService(repo, t.userRepo, t.petRepo, imageSrv)
+	actual, err := srv.FindLikedPets(t.ctx, &proto.FindLikedPetsRequest{UserId: t.userId})
+
+	assert.Nil(t.T(), err)
+	assert.Len(t.T(), actual.Pets, 1)
+	assert.Equal(t.T(), t.petId, actual.Pets[0].Id)
+	assert.Equal(t.T(), string(petConst.ADOPTED), actual.Pets[0].Status)
+	assert.Equal(t.T(), images, actual.Pets[0].Images)
+	assert.Equal(t.T(), &petProto.FindAllPetMetaData{Page: 1, PageSize: defaultLikedPetsPageSize, Total: 1, TotalPages: 1}, actual.Metadata)
+}
+
+func (t *LikeServiceTest) TestFindLikedPetsAfterCursor() {
+	after := &model.Cursor{Sort: "liked_at", ID: uuid.NewString()}
+	query := &like.LikedPetsQuery{UserID: t.userId, After: after, Page: 1, PageSize: 10}
+
+	repo := new(mock.RepositoryMock)
+	repo.On("FindLikedPets", query).Return(&[]*like.Like{}, int64(11), nil)
+	imageSrv := new(imageMock.ServiceMock)
+	imageSrv.On("FindByPetIds", []string{}).Return(map[string][]*imageProto.Image{})
+
+	srv := NewService(repo, t.userRepo, t.petRepo, imageSrv)
+	actual, err := srv.FindLikedPets(t.ctx, &proto.FindLikedPetsRequest{UserId: t.userId, PageSize: 10, Cursor: petUtils.EncodeCursor(after)})
+
+	assert.Nil(t.T(), err)
+	assert.Empty(t.T(), actual.Pets)
+	assert.Equal(t.T(), &petProto.FindAllPetMetaData{Page: 0, PageSize: 10, Total: 11, TotalPages: 2}, actual.Metadata)
+}
+
+func (t *LikeServiceTest) TestFindLikedPetsPage() {
+	repo := new(mock.RepositoryMock)
+	repo.On("FindLikedPets", &like.LikedPetsQuery{UserID: t.userId, Page: 3, PageSize: 5}).Return(&[]*like.Like{}, int64(11), nil)
+	imageSrv := new(imageMock.ServiceMock)
+	imageSrv.On("FindByPetIds", []string{}).Return(map[string][]*imageProto.Image{})
+
+	srv := NewService(repo, t.userRepo, t.petRepo, imageSrv)
+	actual, err := srv.FindLikedPets(t.ctx, &proto.FindLikedPetsRequest{UserId: t.userId, Page: 3, PageSize: 5})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &petProto.FindAllPetMetaData{Page: 3, PageSize: 5, Total: 11, TotalPages: 3}, actual.Metadata)
+}
+
+func (t *LikeServiceTest) TestFindLikedPetsPermissionDenied() {
+	repo := new(mock.RepositoryMock)
+
+	srv := NewService(repo, t.userRepo, t.petRepo, new(imageMock.ServiceMock))
+	actual, err := srv.FindLikedPets(t.ctx, &proto.FindLikedPetsRequest{UserId: uuid.NewString()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+}
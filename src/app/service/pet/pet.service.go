@@ -2,167 +2,563 @@ package pet
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"slices"
+	"time"
 
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
 	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
-	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/pet/v1"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	adoptionProto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
 	image_proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
+)
+
+// number of pets ranked by FindMostLiked and Recommend when no limit is given, and at most
+const (
+	defaultRankLimit = 10
+	maxRankLimit     = 50
+)
+
+const (
+	// number of liked pets the taste of a user is learned from
+	recommendationProfileSize = 100
+	// number of co-liked pets a recommendation is picked from, and of the adoptable pets topping them up
+	recommendationPoolSize = 500
 )
 
 type Service struct {
 	proto.UnimplementedPetServiceServer
-	repository   IRepository
-	imageService ImageService
+	repository      IRepository
+	imageService    ImageService
+	adoptionService AdoptionService
+	likeRepository  ILikeRepository
+	ageBands        petUtils.AgeBands
 }
 
 type IRepository interface {
-	FindAll(*[]*pet.Pet) error
-	FindOne(string, *pet.Pet) error
-	Create(*pet.Pet) error
-	Update(string, *pet.Pet) error
-	Delete(string) error
+	FindAll(context.Context, *pet.FindAllQuery, *[]*pet.Pet, *int64, *model.Cursor) error
+	FindOne(context.Context, string, *pet.Pet) error
+	FindByIds(context.Context, []string, *[]*pet.Pet) error
+	FindAdoptable(context.Context, int, *[]*pet.Pet) error
+	Create(context.Context, *pet.Pet) error
+	Update(context.Context, string, []string, *pet.Pet) error
+	Delete(context.Context, string) error
+	FindDeleted(context.Context, int32, int32, *[]*pet.Pet, *int64) error
+	FindPurgeable(context.Context, time.Time, *[]*pet.Pet) error
+	Restore(context.Context, string) error
+	Purge(context.Context, string) error
+	ApplySchedule(context.Context, time.Time, *[]*pet.Pet, *[]*pet.Pet) error
+}
+
+type ILikeRepository interface {
+	CountByPetIds(context.Context, []string, map[string]int64) error
+	FindLikedPetIds(context.Context, string, []string, *[]string) error
+	RankPets(context.Context, *like.RankQuery, *[]*like.PetRank) error
+	FindLikedPets(context.Context, *like.LikedPetsQuery, *[]*like.Like, *int64, *model.Cursor) error
+	FindCoLikedPets(context.Context, string, int, *[]*like.PetRank) error
 }
 
 type ImageService interface {
-	FindByPetId(petId string) ([]*image_proto.Image, error)
+	FindByPetId(ctx context.Context, petId string) ([]*image_proto.Image, error)
+	FindByPetIds(ctx context.Context, petIds []string) map[string][]*image_proto.Image
+	DeleteByPetId(ctx context.Context, petId string) error
+	Evict(petId string)
 }
 
-func NewService(repository IRepository, imageService ImageService) *Service {
-	return &Service{repository: repository, imageService: imageService}
+type AdoptionService interface {
+	Submit(ctx context.Context, req *adoptionProto.SubmitAdoptionRequest) (*adoptionProto.SubmitAdoptionResponse, error)
+}
+
+func NewService(repository IRepository, imageService ImageService, adoptionService AdoptionService, likeRepository ILikeRepository, ageBands petUtils.AgeBands) *Service {
+	return &Service{
+		repository:      repository,
+		imageService:    imageService,
+		adoptionService: adoptionService,
+		likeRepository:  likeRepository,
+		ageBands:        ageBands,
+	}
 }
 
 func (s *Service) Delete(ctx context.Context, req *proto.DeletePetRequest) (*proto.DeletePetResponse, error) {
-	err := s.repository.Delete(req.Id)
+	err := s.repository.Delete(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, "pet not found")
-		}
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
+	s.imageService.Evict(req.Id)
 	return &proto.DeletePetResponse{Success: true}, nil
 }
 
-func (s *Service) Update(_ context.Context, req *proto.UpdatePetRequest) (res *proto.UpdatePetResponse, err error) {
-	raw, err := petUtils.DtoToRaw(req.Pet)
+// Update writes the fields listed in the petUtils.UpdateMaskHeader metadata, or every non-zero field without it
+func (s *Service) Update(ctx context.Context, req *proto.UpdatePetRequest) (res *proto.UpdatePetResponse, err error) {
+	mask, err := petUtils.UpdateMask(ctx, req.Pet)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error converting dto to raw")
+		return nil, err
 	}
 
-	err = s.repository.Update(req.Pet.Id, raw)
+	if err := petUtils.ValidateUpdate(req.Pet, mask); err != nil {
+		return nil, err
+	}
+	if req.Pet.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
+
+	raw, err := petUtils.DtoToRaw(req.Pet)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "pet not found")
+		return nil, status.Error(codes.InvalidArgument, "invalid pet id")
 	}
+	raw.Version = req.Pet.Version
 
-	images, err := s.imageService.FindByPetId(req.Pet.Id)
+	current := pet.Pet{}
+	err = s.repository.FindOne(ctx, req.Pet.Id, &current)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error querying image service")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
 
-	return &proto.UpdatePetResponse{Pet: petUtils.RawToDto(raw, images)}, nil
-}
+	columns := petUtils.MaskColumns(mask)
+	if err := petUtils.ValidateSchedule(&current, raw, columns); err != nil {
+		return nil, err
+	}
+	if slices.Contains(columns, "status") {
+		if err := petUtils.ValidateStatusTransition(ctx, current.Status, raw.Status); err != nil {
+			return nil, err
+		}
+	}
 
-func (s *Service) ChangeView(_ context.Context, req *proto.ChangeViewPetRequest) (res *proto.ChangeViewPetResponse, err error) {
-	petData, err := s.FindOne(context.Background(), &proto.FindOnePetRequest{Id: req.Id})
+	err = s.repository.Update(ctx, req.Pet.Id, columns, raw)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "pet not found")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
-	pet, err := petUtils.DtoToRaw(petData.Pet)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "error converting dto to raw")
+
+	images := s.findImages(ctx, req.Pet.Id)
+
+	// the times not written by this update are the ones read above
+	if !slices.Contains(columns, "publish_at") {
+		raw.PublishAt = current.PublishAt
+	}
+	if !slices.Contains(columns, "unpublish_at") {
+		raw.UnpublishAt = current.UnpublishAt
 	}
-	pet.IsVisible = req.Visible
 
-	err = s.repository.Update(req.Id, pet)
+	return &proto.UpdatePetResponse{Pet: petUtils.RawToDto(raw, images)}, nil
+}
+
+func (s *Service) ChangeView(ctx context.Context, req *proto.ChangeViewPetRequest) (res *proto.ChangeViewPetResponse, err error) {
+	raw := &pet.Pet{IsVisible: req.Visible, Version: req.Version}
+	err = s.repository.Update(ctx, req.Id, []string{"is_visible"}, raw)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "pet not found")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
 
 	return &proto.ChangeViewPetResponse{Success: true}, nil
 }
 
-func (s *Service) FindAll(_ context.Context, req *proto.FindAllPetRequest) (res *proto.FindAllPetResponse, err error) {
+// FindAll lists the pets matching the request
+func (s *Service) FindAll(ctx context.Context, req *proto.FindAllPetRequest) (res *proto.FindAllPetResponse, err error) {
 	var pets []*pet.Pet
-	var imagesList [][]*image_proto.Image
+	var total int64
 	metaData := proto.FindAllPetMetaData{}
 
-	err = s.repository.FindAll(&pets)
+	query, err := petUtils.FindAllQuery(req, s.ageBands)
+	if err != nil {
+		return nil, err
+	}
+	query.IncludeHidden, err = petUtils.IncludeHidden(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var next model.Cursor
+	err = s.repository.FindAll(ctx, query, &pets, &total, &next)
 	if err != nil {
-		log.Error().Err(err).Str("service", "event").Str("module", "find all").Msg("Error while querying all events")
-		return nil, status.Error(codes.Unavailable, "Internal error")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
 
-	petUtils.FilterPet(&pets, req)
-	petUtils.PaginatePets(&pets, req.Page, req.PageSize, &metaData)
+	petUtils.PaginationMetaData(total, req.Page, req.PageSize, &metaData)
+	if query.After != nil {
+		metaData.Page = 0
+	}
+	metaData.NextCursor = petUtils.NextCursor(&next)
 
+	petIds := make([]string, 0, len(pets))
 	for _, pet := range pets {
-		images, err := s.imageService.FindByPetId(pet.ID.String())
-		if err != nil {
-			return nil, status.Error(codes.Internal, "error querying image service")
-		}
-		imagesList = append(imagesList, images)
+		petIds = append(petIds, pet.ID.String())
 	}
-	petWithImages, err := petUtils.RawToDtoList(&pets, imagesList, req)
+	images := s.imageService.FindByPetIds(ctx, petIds)
+
+	dtos := petUtils.RawToDtoList(&pets, images)
+	s.setLikes(ctx, dtos)
+
+	return &proto.FindAllPetResponse{Pets: dtos, Metadata: &metaData}, nil
+}
+
+// FindOne finds a pet, a hidden pet is only found by an admin asking for hidden pets
+func (s Service) FindOne(ctx context.Context, req *proto.FindOnePetRequest) (res *proto.FindOnePetResponse, err error) {
+	includeHidden, err := petUtils.IncludeHidden(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("error converting raw to dto list: %v", err))
+		return nil, err
 	}
-	return &proto.FindAllPetResponse{Pets: petWithImages, Metadata: &metaData}, nil
-}
 
-func (s Service) FindOne(_ context.Context, req *proto.FindOnePetRequest) (res *proto.FindOnePetResponse, err error) {
 	var pet pet.Pet
 
-	err = s.repository.FindOne(req.Id, &pet)
+	err = s.repository.FindOne(ctx, req.Id, &pet)
 	if err != nil {
-		log.Error().Err(err).
-			Str("service", "pet").Str("module", "find one").Str("id", req.Id).Msg("Not found")
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
-
-	images, err := s.imageService.FindByPetId(req.Id)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "error querying image service")
+	if !pet.IsVisible && !includeHidden {
+		return nil, status.Error(codes.NotFound, "pet not found")
 	}
 
-	return &proto.FindOnePetResponse{Pet: petUtils.RawToDto(&pet, images)}, err
+	images := s.findImages(ctx, req.Id)
+
+	dto := petUtils.RawToDto(&pet, images)
+	s.setLikes(ctx, []*proto.Pet{dto})
+
+	return &proto.FindOnePetResponse{Pet: dto}, err
 }
 
-func (s *Service) Create(_ context.Context, req *proto.CreatePetRequest) (res *proto.CreatePetResponse, err error) {
+func (s *Service) Create(ctx context.Context, req *proto.CreatePetRequest) (res *proto.CreatePetResponse, err error) {
+	if err := petUtils.ValidateCreate(req.Pet); err != nil {
+		return nil, err
+	}
+
 	raw, err := petUtils.DtoToRaw(req.Pet)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error converting dto to raw: "+err.Error())
+		return nil, status.Error(codes.InvalidArgument, "invalid pet id")
+	}
+
+	if err := petUtils.ValidateSchedule(&pet.Pet{}, raw, petUtils.ScheduleColumns); err != nil {
+		return nil, err
 	}
 
 	images := []*image_proto.Image{}
 
-	err = s.repository.Create(raw)
+	err = s.repository.Create(ctx, raw)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create pet")
+		return nil, dbUtils.StatusError(ctx, err, "pet")
 	}
 
 	return &proto.CreatePetResponse{Pet: petUtils.RawToDto(raw, images)}, nil
 }
 
+// AdoptPet submits an adoption application, the pet is only adopted once an admin approves and completes it
 func (s *Service) AdoptPet(ctx context.Context, req *proto.AdoptPetRequest) (res *proto.AdoptPetResponse, err error) {
-	dtoPet, err := s.FindOne(context.Background(), &proto.FindOnePetRequest{Id: req.PetId})
+	_, err = s.adoptionService.Submit(ctx, &adoptionProto.SubmitAdoptionRequest{PetId: req.PetId, UserId: req.UserId})
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "pet not found")
+		return nil, err
 	}
-	pet, err := petUtils.DtoToRaw(dtoPet.Pet)
+
+	return &proto.AdoptPetResponse{Success: true}, nil
+}
+
+// FindDeleted lists the soft-deleted pets for admins, paginated like FindAll
+func (s *Service) FindDeleted(ctx context.Context, req *proto.FindDeletedPetRequest) (*proto.FindAllPetResponse, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	var pets []*pet.Pet
+	var total int64
+	metaData := proto.FindAllPetMetaData{}
+
+	err := s.repository.FindDeleted(ctx, req.Page, req.PageSize, &pets, &total)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error converting dto to raw")
+		return nil, dbUtils.StatusError(ctx, err, "deleted pet")
 	}
-	pet.AdoptBy = req.UserId
 
-	err = s.repository.Update(req.PetId, pet)
+	petUtils.PaginationMetaData(total, req.Page, req.PageSize, &metaData)
+
+	return &proto.FindAllPetResponse{Pets: petUtils.RawToDtoList(&pets, nil), Metadata: &metaData}, nil
+}
+
+// Restore undeletes a soft-deleted pet
+func (s *Service) Restore(ctx context.Context, req *proto.RestorePetRequest) (*proto.RestorePetResponse, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	err := s.repository.Restore(ctx, req.Id)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "pet not found")
+		return nil, dbUtils.StatusError(ctx, err, "deleted pet")
 	}
 
-	return &proto.AdoptPetResponse{Success: true}, nil
+	return &proto.RestorePetResponse{Success: true}, nil
+}
+
+// Purge permanently removes the pets deleted longer than the retention with their likes and images
+func (s *Service) Purge(ctx context.Context, retention time.Duration) (int, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return 0, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	var pets []*pet.Pet
+	err := s.repository.FindPurgeable(ctx, time.Now().Add(-retention), &pets)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("service", "pet").Str("module", "purge").Msg("Error while querying purgeable pets")
+		return 0, status.Error(codes.Internal, "internal error")
+	}
+
+	purged := 0
+	for _, p := range pets {
+		id := p.ID.String()
+
+		if err := s.imageService.DeleteByPetId(ctx, id); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("service", "pet").Str("module", "purge").Str("id", id).Msg("Skipping pet, its images could not be deleted")
+			continue
+		}
+
+		if err := s.repository.Purge(ctx, id); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("service", "pet").Str("module", "purge").Str("id", id).Msg("Error while purging pet")
+			continue
+		}
+		s.imageService.Evict(id)
+
+		purged++
+	}
+
+	return purged, nil
+}
+
+// ApplySchedule publishes and unpublishes the pets that are due and returns how many were changed
+func (s *Service) ApplySchedule(ctx context.Context) (int, error) {
+	if !authUtils.IsAdmin(ctx) {
+		return 0, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	var published, unpublished []*pet.Pet
+	err := s.repository.ApplySchedule(ctx, time.Now(), &published, &unpublished)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("service", "pet").Str("module", "schedule").Msg("Error while applying the publishing schedule")
+		return 0, status.Error(codes.Internal, "internal error")
+	}
+
+	for _, p := range published {
+		log.Ctx(ctx).Info().Str("service", "pet").Str("module", "schedule").Str("id", p.ID.String()).Msg("Published scheduled pet")
+	}
+	for _, p := range unpublished {
+		log.Ctx(ctx).Info().Str("service", "pet").Str("module", "schedule").Str("id", p.ID.String()).Msg("Unpublished scheduled pet")
+	}
+
+	return len(published) + len(unpublished), nil
+}
+
+// FindMostLiked ranks the adoptable pets by their likes within req.Window, or of all time without it
+func (s *Service) FindMostLiked(ctx context.Context, req *proto.FindMostLikedPetRequest) (*proto.FindAllPetResponse, error) {
+	var window time.Duration
+	if req.Window != nil {
+		window = req.Window.AsDuration()
+		if !req.Window.IsValid() || window <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid window")
+		}
+	}
+
+	return s.mostLiked(ctx, req.Type, window, req.Limit)
+}
+
+// mostLiked ranks the adoptable pets by their likes within the window, or of all time when it is 0
+func (s *Service) mostLiked(ctx context.Context, petType string, window time.Duration, limit int32) (*proto.FindAllPetResponse, error) {
+	if limit <= 0 {
+		limit = defaultRankLimit
+	}
+	if limit > maxRankLimit {
+		limit = maxRankLimit
+	}
+
+	query := &like.RankQuery{Type: petType, Limit: int(limit)}
+	if window > 0 {
+		since := time.Now().Add(-window)
+		query.Since = &since
+	}
+
+	var ranks []*like.PetRank
+	err := s.likeRepository.RankPets(ctx, query, &ranks)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	petIds := make([]string, 0, len(ranks))
+	for _, rank := range ranks {
+		petIds = append(petIds, rank.PetID)
+	}
+
+	var found []*pet.Pet
+	if len(petIds) > 0 {
+		err = s.repository.FindByIds(ctx, petIds, &found)
+		if err != nil {
+			return nil, dbUtils.StatusError(ctx, err, "pet")
+		}
+	}
+
+	byId := make(map[string]*pet.Pet, len(found))
+	for _, p := range found {
+		byId[p.ID.String()] = p
+	}
+
+	// keep the ranking order, leaving out the pets deleted since ranking
+	pets := make([]*pet.Pet, 0, len(ranks))
+	foundIds := make([]string, 0, len(ranks))
+	for _, petId := range petIds {
+		if p, ok := byId[petId]; ok {
+			pets = append(pets, p)
+			foundIds = append(foundIds, petId)
+		}
+	}
+
+	images := s.imageService.FindByPetIds(ctx, foundIds)
+
+	metaData := proto.FindAllPetMetaData{}
+	petUtils.PaginationMetaData(int64(len(pets)), 1, limit, &metaData)
+
+	dtos := petUtils.RawToDtoList(&pets, images)
+	s.setLikes(ctx, dtos)
+
+	return &proto.FindAllPetResponse{Pets: dtos, Metadata: &metaData}, nil
+}
+
+// Recommend suggests adoptable pets for the user from co-likes and the attributes of the pets the user likes
+func (s *Service) Recommend(ctx context.Context, req *proto.RecommendPetRequest) (*proto.FindAllPetResponse, error) {
+	userId := req.UserId
+	if !authUtils.CanActAs(ctx, userId) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRankLimit
+	}
+	if limit > maxRankLimit {
+		limit = maxRankLimit
+	}
+
+	var likes []*like.Like
+	var total int64
+	query := &like.LikedPetsQuery{UserID: userId, PageSize: recommendationProfileSize}
+	err := s.likeRepository.FindLikedPets(ctx, query, &likes, &total, &model.Cursor{})
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+
+	liked := make([]*pet.Pet, 0, len(likes))
+	likedIds := make(map[string]bool, len(likes))
+	for _, l := range likes {
+		if l.Pet != nil {
+			liked = append(liked, l.Pet)
+			likedIds[l.Pet.ID.String()] = true
+		}
+	}
+	if len(liked) == 0 {
+		return s.mostLiked(ctx, "", 0, limit)
+	}
+
+	var ranks []*like.PetRank
+	err = s.likeRepository.FindCoLikedPets(ctx, userId, recommendationPoolSize, &ranks)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "like")
+	}
+	coLikes := make(map[string]int64, len(ranks))
+	coLikedIds := make([]string, 0, len(ranks))
+	for _, rank := range ranks {
+		coLikes[rank.PetID] = rank.LikeCount
+		coLikedIds = append(coLikedIds, rank.PetID)
+	}
+
+	candidates := make([]*pet.Pet, 0, len(ranks))
+	seen := make(map[string]bool, len(ranks))
+	addCandidates := func(pets []*pet.Pet) {
+		for _, p := range pets {
+			id := p.ID.String()
+			if !seen[id] && !likedIds[id] && p.Status == petConst.FINDHOME && p.IsVisible {
+				seen[id] = true
+				candidates = append(candidates, p)
+			}
+		}
+	}
+
+	if len(coLikedIds) > 0 {
+		var coLiked []*pet.Pet
+		err = s.repository.FindByIds(ctx, coLikedIds, &coLiked)
+		if err != nil {
+			return nil, dbUtils.StatusError(ctx, err, "pet")
+		}
+		// in the order of their co-likes, so ties are broken by it
+		slices.SortStableFunc(coLiked, func(a, b *pet.Pet) int {
+			return int(coLikes[b.ID.String()] - coLikes[a.ID.String()])
+		})
+		addCandidates(coLiked)
+	}
+
+	// too few co-liked pets, top up with the newest adoptable pets to be ranked by their attributes
+	if len(candidates) < int(limit) {
+		var adoptable []*pet.Pet
+		err = s.repository.FindAdoptable(ctx, recommendationPoolSize, &adoptable)
+		if err != nil {
+			return nil, dbUtils.StatusError(ctx, err, "pet")
+		}
+		addCandidates(adoptable)
+	}
+
+	pets := petUtils.Recommend(petUtils.NewProfile(liked, s.ageBands, time.Now()), candidates, coLikes, int(limit))
+
+	petIds := make([]string, 0, len(pets))
+	for _, p := range pets {
+		petIds = append(petIds, p.ID.String())
+	}
+	images := s.imageService.FindByPetIds(ctx, petIds)
+
+	metaData := proto.FindAllPetMetaData{}
+	petUtils.PaginationMetaData(int64(len(pets)), 1, limit, &metaData)
+
+	dtos := petUtils.RawToDtoList(&pets, images)
+	s.setLikes(ctx, dtos)
+
+	return &proto.FindAllPetResponse{Pets: dtos, Metadata: &metaData}, nil
+}
+
+// findImages finds the images of the pet, the pet is sent without images when the image service fails
+func (s *Service) findImages(ctx context.Context, petId string) []*image_proto.Image {
+	images, err := s.imageService.FindByPetId(ctx, petId)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("service", "pet").Str("module", "find images").Str("pet_id", petId).Msg("Sending pet without images")
+		return []*image_proto.Image{}
+	}
+
+	return images
+}
+
+// setLikes sets the like stats of the pets, they are left out when they cannot be queried
+func (s *Service) setLikes(ctx context.Context, pets []*proto.Pet) {
+	if len(pets) == 0 {
+		return
+	}
+
+	petIds := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		petIds = append(petIds, pet.Id)
+	}
+
+	counts := make(map[string]int64, len(petIds))
+	err := s.likeRepository.CountByPetIds(ctx, petIds, counts)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("service", "pet").Str("module", "set likes").Msg("Error while counting likes")
+		return
+	}
+
+	var liked []string
+	if identity, ok := authUtils.IdentityFromContext(ctx); ok {
+		err = s.likeRepository.FindLikedPetIds(ctx, identity.UserId, petIds, &liked)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("service", "pet").Str("module", "set likes").Msg("Error while finding liked pets")
+		}
+	}
+
+	for _, pet := range pets {
+		pet.LikeCount = counts[pet.Id]
+		pet.Liked = slices.Contains(liked, pet.Id)
+	}
 }
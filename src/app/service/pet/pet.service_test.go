@@ -9,21 +9,34 @@ import (
 
 	"github.com/bxcodec/faker/v3"
 	"github.com/google/uuid"
+	adoptionMock "github.com/isd-sgcu/johnjud-backend/src/mocks/adoption"
 	img_mock "github.com/isd-sgcu/johnjud-backend/src/mocks/image"
+	likeMock "github.com/isd-sgcu/johnjud-backend/src/mocks/like"
 	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/pet"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 
 	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
-	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/pet/v1"
+	adoptionProto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
 	img_proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
 
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
 	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	tMock "github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PetServiceTest struct {
@@ -40,6 +53,7 @@ type PetServiceTest struct {
 	ImagesList           [][]*img_proto.Image
 	ChangeAdoptBy        *pet.Pet
 	AdoptByReq           *proto.AdoptPetRequest
+	LikeRepo             *likeMock.RepositoryMock
 }
 
 func TestPetService(t *testing.T) {
@@ -47,6 +61,10 @@ func TestPetService(t *testing.T) {
 }
 
 func (t *PetServiceTest) SetupTest() {
+	t.LikeRepo = new(likeMock.RepositoryMock)
+	t.LikeRepo.On("CountByPetIds", tMock.Anything).Return(map[string]int64{}, nil).Maybe()
+	t.LikeRepo.On("FindLikedPetIds", tMock.Anything, tMock.Anything).Return([]string{}, nil).Maybe()
+
 	var pets []*pet.Pet
 	genders := []petConst.Gender{petConst.MALE, petConst.FEMALE}
 	statuses := []petConst.Status{petConst.ADOPTED, petConst.FINDHOME}
@@ -61,7 +79,7 @@ func (t *PetServiceTest) SetupTest() {
 			},
 			Type:         faker.Word(),
 			Name:         faker.Name(),
-			Birthdate:    faker.Word(),
+			Birthdate:    yearsAgo(rand.Intn(10)),
 			Gender:       genders[rand.Intn(2)],
 			Color:        faker.Word(),
 			Pattern:      faker.Word(),
@@ -75,6 +93,7 @@ func (t *PetServiceTest) SetupTest() {
 			Address:      faker.Paragraph(),
 			Contact:      faker.Paragraph(),
 			AdoptBy:      "",
+			Version:      1,
 		}
 		var images []*img_proto.Image
 		var imageUrls []string
@@ -99,7 +118,7 @@ func (t *PetServiceTest) SetupTest() {
 		Id:           t.Pet.ID.String(),
 		Type:         t.Pet.Type,
 		Name:         t.Pet.Name,
-		Birthdate:    t.Pet.Birthdate,
+		Birthdate:    petUtils.FormatBirthdate(t.Pet.Birthdate),
 		Gender:       string(t.Pet.Gender),
 		Color:        t.Pet.Color,
 		Pattern:      t.Pet.Pattern,
@@ -113,6 +132,7 @@ func (t *PetServiceTest) SetupTest() {
 		Address:      t.Pet.Address,
 		Contact:      t.Pet.Contact,
 		Images:       t.Images,
+		Version:      t.Pet.Version,
 	}
 
 	t.UpdatePet = &pet.Pet{
@@ -137,6 +157,7 @@ func (t *PetServiceTest) SetupTest() {
 		Origin:       t.Pet.Origin,
 		Address:      t.Pet.Address,
 		Contact:      t.Pet.Contact,
+		Version:      t.Pet.Version,
 	}
 
 	t.ChangeViewPet = &pet.Pet{
@@ -167,7 +188,7 @@ func (t *PetServiceTest) SetupTest() {
 		Pet: &proto.Pet{
 			Type:         t.Pet.Type,
 			Name:         t.Pet.Name,
-			Birthdate:    t.Pet.Birthdate,
+			Birthdate:    petUtils.FormatBirthdate(t.Pet.Birthdate),
 			Gender:       string(t.Pet.Gender),
 			Color:        t.Pet.Color,
 			Pattern:      t.Pet.Pattern,
@@ -189,7 +210,7 @@ func (t *PetServiceTest) SetupTest() {
 			Id:           t.Pet.ID.String(),
 			Type:         t.Pet.Type,
 			Name:         t.Pet.Name,
-			Birthdate:    t.Pet.Birthdate,
+			Birthdate:    petUtils.FormatBirthdate(t.Pet.Birthdate),
 			Gender:       string(t.Pet.Gender),
 			Color:        t.Pet.Color,
 			Pattern:      t.Pet.Pattern,
@@ -203,6 +224,7 @@ func (t *PetServiceTest) SetupTest() {
 			Origin:       t.Pet.Origin,
 			Address:      t.Pet.Address,
 			Contact:      t.Pet.Contact,
+			Version:      t.Pet.Version,
 		},
 	}
 
@@ -248,13 +270,15 @@ func (t *PetServiceTest) TestDeleteSuccess() {
 	repo := new(mock.RepositoryMock)
 	repo.On("Delete", t.Pet.ID.String()).Return(nil)
 	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("Evict", t.Pet.ID.String())
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	actual, err := srv.Delete(context.Background(), &proto.DeletePetRequest{Id: t.Pet.ID.String()})
 
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
 	repo.AssertExpectations(t.T())
+	imgSrv.AssertExpectations(t.T())
 }
 
 func (t *PetServiceTest) TestDeleteNotFound() {
@@ -262,7 +286,7 @@ func (t *PetServiceTest) TestDeleteNotFound() {
 	repo.On("Delete", t.Pet.ID.String()).Return(gorm.ErrRecordNotFound)
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	_, err := srv.Delete(context.Background(), &proto.DeletePetRequest{Id: t.Pet.ID.String()})
 
 	st, ok := status.FromError(err)
@@ -276,7 +300,7 @@ func (t *PetServiceTest) TestDeleteWithDatabaseError() {
 	repo.On("Delete", t.Pet.ID.String()).Return(errors.New("internal server error"))
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	_, err := srv.Delete(context.Background(), &proto.DeletePetRequest{Id: t.Pet.ID.String()})
 
 	st, ok := status.FromError(err)
@@ -290,13 +314,27 @@ func (t *PetServiceTest) TestDeleteWithUnexpectedError() {
 	repo.On("Delete", t.Pet.ID.String()).Return(errors.New("unexpected error"))
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	_, err := srv.Delete(context.Background(), &proto.DeletePetRequest{Id: t.Pet.ID.String()})
 
 	assert.Error(t.T(), err)
 	repo.AssertExpectations(t.T())
 }
 
+func (t *PetServiceTest) TestDeleteInvalidId() {
+	repo := new(mock.RepositoryMock)
+	repo.On("Delete", "abc").Return(&pgconn.PgError{Code: "22P02"})
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	_, err := srv.Delete(context.Background(), &proto.DeletePetRequest{Id: "abc"})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertExpectations(t.T())
+}
+
 func (t *PetServiceTest) TestFindOneSuccess() {
 	want := &proto.FindOnePetResponse{Pet: t.PetDto}
 
@@ -305,13 +343,63 @@ func (t *PetServiceTest) TestFindOneSuccess() {
 	imgSrv := new(img_mock.ServiceMock)
 	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindOne(context.Background(), &proto.FindOnePetRequest{Id: t.Pet.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestFindOneWithoutImages() {
+	t.PetDto.Images = []*img_proto.Image{}
+	want := &proto.FindOnePetResponse{Pet: t.PetDto}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	actual, err := srv.FindOne(context.Background(), &proto.FindOnePetRequest{Id: t.Pet.ID.String()})
 
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
 }
 
+func (t *PetServiceTest) TestFindOneHidden() {
+	t.Pet.IsVisible = false
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindOne(context.Background(), &proto.FindOnePetRequest{Id: t.Pet.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+	imgSrv.AssertNotCalled(t.T(), "FindByPetId", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindOneHiddenByAdmin() {
+	t.Pet.IsVisible = false
+	t.PetDto.IsVisible = false
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	ctx := metadata.NewIncomingContext(t.adminContext(), metadata.Pairs(petUtils.IncludeHiddenHeader, "true"))
+	actual, err := srv.FindOne(ctx, &proto.FindOnePetRequest{Id: t.Pet.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.FindOnePetResponse{Pet: t.PetDto}, actual)
+}
+
 func (t *PetServiceTest) TestFindAllSuccess() {
 
 	want := &proto.FindAllPetResponse{
@@ -327,27 +415,275 @@ func (t *PetServiceTest) TestFindAllSuccess() {
 	var petsIn []*pet.Pet
 
 	repo := &mock.RepositoryMock{}
-	repo.On("FindAll", petsIn).Return(&t.Pets, nil)
+	repo.On("FindAll", &pet.FindAllQuery{}, petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(t.imagesMap(t.Pets, t.ImagesList))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestFindAllPaginated() {
+	pets := t.Pets[2:]
+
+	want := &proto.FindAllPetResponse{
+		Pets: t.createPetsDto(pets, t.ImagesList[2:]),
+		Metadata: &proto.FindAllPetMetaData{
+			Page:       2,
+			TotalPages: 2,
+			PageSize:   2,
+			Total:      int32(len(t.Pets)),
+		},
+	}
+
+	var petsIn []*pet.Pet
+	query := &pet.FindAllQuery{Type: t.Pet.Type, Page: 2, PageSize: 2}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", query, petsIn).Return(&pets, int64(len(t.Pets)), nil)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(pets)).Return(t.imagesMap(pets, t.ImagesList[2:]))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Type: t.Pet.Type, Page: 2, PageSize: 2})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestFindAllSorted() {
+	var petsIn []*pet.Pet
+	query := &pet.FindAllQuery{Sort: petConst.MOST_LIKED}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", query, petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(t.imagesMap(t.Pets, t.ImagesList))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Sort: "most_liked"})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.dtoIds(t.createPetsDto(t.Pets, t.ImagesList)), t.dtoIds(actual.Pets))
+}
+
+func (t *PetServiceTest) TestFindAllInvalidSort() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Sort: "cutest"})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "FindAll", tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindAllAfterCursor() {
+	pets := t.Pets[2:]
+	after := &model.Cursor{Sort: string(petConst.NEWEST), ID: t.Pets[1].ID.String()}
+	next := &model.Cursor{Sort: string(petConst.NEWEST), ID: pets[len(pets)-1].ID.String()}
+
+	var petsIn []*pet.Pet
+	query := &pet.FindAllQuery{After: after, Page: 2, PageSize: 2}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", query, petsIn).Return(&pets, int64(len(t.Pets)), nil, next)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(pets)).Return(t.imagesMap(pets, t.ImagesList[2:]))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Page: 2, PageSize: 2, Cursor: petUtils.EncodeCursor(after)})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds(pets), t.dtoIds(actual.Pets))
+	assert.Equal(t.T(), &proto.FindAllPetMetaData{Page: 0, PageSize: 2, Total: int32(len(t.Pets)), TotalPages: 2, NextCursor: petUtils.EncodeCursor(next)}, actual.Metadata)
+}
+
+func (t *PetServiceTest) TestFindAllInvalidCursor() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{PageSize: 2, Cursor: "page-2"})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "FindAll", tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindAllCursorOfAnotherSort() {
+	after := &model.Cursor{Sort: string(petConst.NAME), ID: t.Pets[1].ID.String()}
+
+	var petsIn []*pet.Pet
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", &pet.FindAllQuery{After: after, PageSize: 2}, petsIn).Return(nil, int64(0), model.ErrInvalidCursor)
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{PageSize: 2, Cursor: petUtils.EncodeCursor(after)})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+}
+
+func (t *PetServiceTest) TestFindAllIncludeHiddenByAdmin() {
+	var petsIn []*pet.Pet
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", &pet.FindAllQuery{IncludeHidden: true}, petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(t.imagesMap(t.Pets, t.ImagesList))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	ctx := metadata.NewIncomingContext(t.adminContext(), metadata.Pairs(petUtils.IncludeHiddenHeader, "true"))
+	actual, err := srv.FindAll(ctx, &proto.FindAllPetRequest{})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds(t.Pets), t.dtoIds(actual.Pets))
+}
+
+func (t *PetServiceTest) TestFindAllIncludeHiddenPermissionDenied() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.IncludeHiddenHeader, "true"))
+	actual, err := srv.FindAll(ctx, &proto.FindAllPetRequest{})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+	repo.AssertNotCalled(t.T(), "FindAll", tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindAllByAgeBand() {
+	var petsIn []*pet.Pet
+	bornBy := *yearsAgo(0)
+	bornAfter := *yearsAgo(1)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", tMock.MatchedBy(func(query *pet.FindAllQuery) bool {
+		return query.Type == "dog" && len(query.Birthdates) == 1 &&
+			query.Birthdates[0].Type == "dog" &&
+			query.Birthdates[0].From.Equal(bornAfter) &&
+			query.Birthdates[0].To.Equal(bornBy)
+	}), petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
+
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(t.imagesMap(t.Pets, t.ImagesList))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Type: "dog", Age: "puppy"})
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds(t.Pets), t.dtoIds(actual.Pets))
+}
+
+func (t *PetServiceTest) TestFindAllByAgeInMonths() {
+	var petsIn []*pet.Pet
+	bornBy := yearsAgo(0).AddDate(0, -6, 0)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", tMock.MatchedBy(func(query *pet.FindAllQuery) bool {
+		return len(query.Birthdates) == 1 &&
+			query.Birthdates[0].Type == "" &&
+			query.Birthdates[0].From == nil &&
+			query.Birthdates[0].To.Equal(bornBy)
+	}), petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
 
 	imgSrv := new(img_mock.ServiceMock)
-	for i, pet := range t.Pets {
-		imgSrv.On("FindByPetId", pet.ID.String()).Return(t.ImagesList[i], nil)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(t.imagesMap(t.Pets, t.ImagesList))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Age: "6-"})
+	assert.Nil(t.T(), err)
+	assert.Len(t.T(), actual.Pets, len(t.Pets))
+}
+
+func (t *PetServiceTest) TestFindAllUnknownAgeBand() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{Type: "cat", Age: "puppy"})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "FindAll", tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindAllImageServiceError() {
+	want := &proto.FindAllPetResponse{
+		Pets: t.createPetsDto(t.Pets, t.ImagesList),
+		Metadata: &proto.FindAllPetMetaData{
+			Page:       1,
+			TotalPages: 1,
+			PageSize:   int32(len(t.Pets)),
+			Total:      int32(len(t.Pets)),
+		},
 	}
+	want.Pets[1].Images = nil
+
+	var petsIn []*pet.Pet
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", &pet.FindAllQuery{}, petsIn).Return(&t.Pets, int64(len(t.Pets)), nil)
+
+	images := t.imagesMap(t.Pets, t.ImagesList)
+	delete(images, t.Pets[1].ID.String())
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", t.petIds(t.Pets)).Return(images)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 
 	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{})
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
 }
 
+func (t *PetServiceTest) TestFindAllInternalErr() {
+	var petsIn []*pet.Pet
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAll", &pet.FindAllQuery{}, petsIn).Return(nil, int64(0), errors.New("something wrong"))
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.FindAll(context.Background(), &proto.FindAllPetRequest{})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, st.Code())
+}
+
 func (t *PetServiceTest) TestFindOneNotFound() {
 	repo := &mock.RepositoryMock{}
-	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(nil, errors.New("Not found pet"))
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(nil, gorm.ErrRecordNotFound)
 	imgSrv := new(img_mock.ServiceMock)
 	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(nil, nil)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	actual, err := srv.FindOne(context.Background(), &proto.FindOnePetRequest{Id: t.Pet.ID.String()})
 
 	st, ok := status.FromError(err)
@@ -372,7 +708,7 @@ func createPets() []*pet.Pet {
 			},
 			Type:         faker.Word(),
 			Name:         faker.Name(),
-			Birthdate:    faker.Word(),
+			Birthdate:    yearsAgo(rand.Intn(10)),
 			Gender:       genders[rand.Intn(2)],
 			Color:        faker.Word(),
 			Pattern:      faker.Word(),
@@ -400,7 +736,7 @@ func (t *PetServiceTest) createPetsDto(in []*pet.Pet, imagesList [][]*img_proto.
 			Id:           p.ID.String(),
 			Type:         p.Type,
 			Name:         p.Name,
-			Birthdate:    p.Birthdate,
+			Birthdate:    petUtils.FormatBirthdate(p.Birthdate),
 			Gender:       string(p.Gender),
 			Color:        p.Color,
 			Pattern:      p.Pattern,
@@ -414,6 +750,7 @@ func (t *PetServiceTest) createPetsDto(in []*pet.Pet, imagesList [][]*img_proto.
 			Origin:       p.Origin,
 			Address:      p.Address,
 			Contact:      p.Contact,
+			Version:      p.Version,
 		}
 
 		result = append(result, r)
@@ -422,6 +759,22 @@ func (t *PetServiceTest) createPetsDto(in []*pet.Pet, imagesList [][]*img_proto.
 	return result
 }
 
+func (t *PetServiceTest) petIds(in []*pet.Pet) []string {
+	var result []string
+	for _, p := range in {
+		result = append(result, p.ID.String())
+	}
+	return result
+}
+
+func (t *PetServiceTest) imagesMap(in []*pet.Pet, imagesList [][]*img_proto.Image) map[string][]*img_proto.Image {
+	result := make(map[string][]*img_proto.Image)
+	for i, p := range in {
+		result[p.ID.String()] = imagesList[i]
+	}
+	return result
+}
+
 func (t *PetServiceTest) TestCreateSuccess() {
 	want := &proto.CreatePetResponse{Pet: t.PetDto}
 	want.Pet.Images = []*img_proto.Image{} // when pet is first created, it has no images
@@ -449,7 +802,7 @@ func (t *PetServiceTest) TestCreateSuccess() {
 	repo.On("Create", in).Return(t.Pet, nil)
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 
 	actual, err := srv.Create(context.Background(), t.CreatePetReqMock)
 
@@ -457,6 +810,39 @@ func (t *PetServiceTest) TestCreateSuccess() {
 	assert.Equal(t.T(), want, actual)
 }
 
+func (t *PetServiceTest) TestCreateInvalidArgument() {
+	t.CreatePetReqMock.Pet.Name = ""
+	t.CreatePetReqMock.Pet.Status = ""
+
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.Create(context.Background(), t.CreatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestCreateAlreadyExists() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Create", tMock.Anything).Return(nil, &pgconn.PgError{Code: "23505"})
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+
+	actual, err := srv.Create(context.Background(), t.CreatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, st.Code())
+}
+
 func (t *PetServiceTest) TestCreateInternalErr() {
 	repo := &mock.RepositoryMock{}
 
@@ -481,7 +867,7 @@ func (t *PetServiceTest) TestCreateInternalErr() {
 	repo.On("Create", in).Return(nil, errors.New("something wrong"))
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 
 	actual, err := srv.Create(context.Background(), t.CreatePetReqMock)
 
@@ -496,116 +882,774 @@ func (t *PetServiceTest) TestUpdateSuccess() {
 	want := &proto.UpdatePetResponse{Pet: t.PetDto}
 
 	repo := &mock.RepositoryMock{}
-	repo.On("Update", t.Pet.ID.String(), t.UpdatePet).Return(t.Pet, nil)
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(t.Pet, nil)
 	imgSrv := new(img_mock.ServiceMock)
 	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
 
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
 }
 
-func (t *PetServiceTest) TestUpdateNotFound() {
+func (t *PetServiceTest) TestUpdateWithoutImages() {
+	t.PetDto.Images = []*img_proto.Image{}
+	want := &proto.UpdatePetResponse{Pet: t.PetDto}
+
 	repo := &mock.RepositoryMock{}
-	repo.On("Update", t.Pet.ID.String(), t.UpdatePet).Return(nil, errors.New("Not found pet"))
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(t.Pet, nil)
 	imgSrv := new(img_mock.ServiceMock)
-	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(nil, status.Error(codes.Unavailable, "Connection Timeout"))
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
 	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
 
-	st, ok := status.FromError(err)
-
-	assert.True(t.T(), ok)
-	assert.Nil(t.T(), actual)
-	assert.Equal(t.T(), codes.NotFound, st.Code())
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+	repo.AssertExpectations(t.T())
 }
 
-func (t *PetServiceTest) TestChangeViewSuccess() {
-	want := &proto.ChangeViewPetResponse{Success: true}
+func (t *PetServiceTest) TestUpdateWithMask() {
+	t.Pet.Status = petConst.ADOPTED
+	t.Pet.IsVisible = false
+	t.UpdatePetReqMock.Pet = &proto.Pet{
+		Id:        t.Pet.ID.String(),
+		Status:    string(petConst.FINDHOME),
+		IsVisible: false,
+		AdoptBy:   "",
+		Version:   t.Pet.Version,
+	}
 
 	repo := &mock.RepositoryMock{}
 	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
-	repo.On("Update", t.Pet.ID.String(), t.ChangeViewPet).Return(t.ChangeViewPet, nil)
+	repo.On("Update", t.Pet.ID.String(), []string{"adopt_by", "is_visible"}, tMock.Anything).Return(t.Pet, nil)
 	imgSrv := new(img_mock.ServiceMock)
 	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
 
-	srv := NewService(repo, imgSrv)
-	actual, err := srv.ChangeView(context.Background(), t.ChangeViewPetReqMock)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.UpdateMaskHeader, "isVisible, adoptBy"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(ctx, t.UpdatePetReqMock)
 
 	assert.Nil(t.T(), err)
-	assert.Equal(t.T(), want, actual)
+	assert.False(t.T(), actual.Pet.IsVisible)
+	assert.Equal(t.T(), string(petConst.ADOPTED), actual.Pet.Status)
 }
 
-func (t *PetServiceTest) TestChangeViewNotFound() {
+func (t *PetServiceTest) TestUpdateWithInvalidMask() {
 	repo := &mock.RepositoryMock{}
-	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(nil, errors.New("Not found pet"))
-	repo.On("Update", t.Pet.ID.String(), t.UpdatePet).Return(nil, errors.New("Not found pet"))
 	imgSrv := new(img_mock.ServiceMock)
 
-	srv := NewService(repo, imgSrv)
-	actual, err := srv.ChangeView(context.Background(), t.ChangeViewPetReqMock)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.UpdateMaskHeader, "id,images"))
 
-	st, ok := status.FromError(err)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(ctx, t.UpdatePetReqMock)
 
+	st, ok := status.FromError(err)
 	assert.True(t.T(), ok)
 	assert.Nil(t.T(), actual)
-	assert.Equal(t.T(), codes.NotFound, st.Code())
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	assert.Len(t.T(), st.Details()[0].(*errdetails.BadRequest).FieldViolations, 2)
 }
 
-func (t *PetServiceTest) TestAdoptBySuccess() {
-	want := &proto.AdoptPetResponse{Success: true}
+func (t *PetServiceTest) TestUpdateWithMaskClearingRequiredField() {
+	t.UpdatePetReqMock.Pet.Name = ""
+
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.UpdateMaskHeader, "name"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(ctx, t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+}
+
+func (t *PetServiceTest) TestUpdateInvalidArgument() {
+	t.UpdatePetReqMock.Pet.Gender = "unknown"
+	t.UpdatePetReqMock.Pet.Birthdate = "yesterday"
+
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	assert.Len(t.T(), st.Details(), 1)
+	assert.Len(t.T(), st.Details()[0].(*errdetails.BadRequest).FieldViolations, 2)
+	repo.AssertNotCalled(t.T(), "Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet)
+}
+
+func (t *PetServiceTest) TestUpdateIllegalStatusTransition() {
+	t.Pet.Status = petConst.ADOPTED
+	t.UpdatePetReqMock.Pet.Status = string(petConst.FINDHOME)
+
 	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(t.adminContext(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.FailedPrecondition, st.Code())
+}
 
-	repo.On("FindOne", t.AdoptByReq.PetId, &pet.Pet{}).Return(t.Pet, nil)
-	repo.On("Update", t.AdoptByReq.PetId, t.ChangeAdoptBy).Return(t.ChangeAdoptBy, nil)
+func (t *PetServiceTest) TestUpdateStatusOverride() {
+	t.Pet.Status = petConst.ADOPTED
+	t.UpdatePetReqMock.Pet.Status = string(petConst.FINDHOME)
+	t.UpdatePet.Status = petConst.FINDHOME
+	t.PetDto.Status = string(petConst.FINDHOME)
+	want := &proto.UpdatePetResponse{Pet: t.PetDto}
 
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(t.UpdatePet, nil)
 	imgSrv := new(img_mock.ServiceMock)
 	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
 
-	srv := NewService(repo, imgSrv)
+	ctx := metadata.NewIncomingContext(t.adminContext(), metadata.Pairs(petUtils.StatusOverrideHeader, "true"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(ctx, t.UpdatePetReqMock)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestUpdateWithoutVersion() {
+	t.UpdatePetReqMock.Pet.Version = 0
+
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	repo.AssertNotCalled(t.T(), "Update", tMock.Anything, tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestUpdateVersionConflict() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(nil, model.ErrVersionConflict)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Aborted, st.Code())
+}
+
+func (t *PetServiceTest) TestUpdateInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(nil, errors.New("connection reset"))
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, st.Code())
+}
+
+func (t *PetServiceTest) TestUpdateNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Update", t.Pet.ID.String(), tMock.Anything, t.UpdatePet).Return(nil, errors.New("Not found pet"))
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	st, ok := status.FromError(err)
+
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *PetServiceTest) TestChangeViewSuccess() {
+	want := &proto.ChangeViewPetResponse{Success: true}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("Update", t.Pet.ID.String(), []string{"is_visible"}, &pet.Pet{IsVisible: false}).Return(t.ChangeViewPet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.ChangeView(context.Background(), t.ChangeViewPetReqMock)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestChangeViewNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Update", t.Pet.ID.String(), []string{"is_visible"}, &pet.Pet{IsVisible: false}).Return(nil, gorm.ErrRecordNotFound)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.ChangeView(context.Background(), t.ChangeViewPetReqMock)
+
+	st, ok := status.FromError(err)
+
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *PetServiceTest) TestChangeViewVersionConflict() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Update", t.Pet.ID.String(), []string{"is_visible"}, &pet.Pet{IsVisible: false, Version: 2}).Return(nil, model.ErrVersionConflict)
+	imgSrv := new(img_mock.ServiceMock)
+
+	t.ChangeViewPetReqMock.Version = 2
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.ChangeView(context.Background(), t.ChangeViewPetReqMock)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Aborted, st.Code())
+}
+
+func (t *PetServiceTest) TestAdoptBySuccess() {
+	want := &proto.AdoptPetResponse{Success: true}
+
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+	adoptionSrv := new(adoptionMock.ServiceMock)
+	adoptionSrv.On("Submit", &adoptionProto.SubmitAdoptionRequest{PetId: t.AdoptByReq.PetId, UserId: t.AdoptByReq.UserId}).Return(&adoptionProto.SubmitAdoptionResponse{}, nil)
+
+	srv := NewService(repo, imgSrv, adoptionSrv, t.LikeRepo, petUtils.DefaultAgeBands)
 
 	actual, err := srv.AdoptPet(context.Background(), t.AdoptByReq)
 
 	assert.Nil(t.T(), err)
 	assert.Equal(t.T(), want, actual)
+	repo.AssertNotCalled(t.T(), "Update", t.AdoptByReq.PetId, tMock.Anything, t.ChangeAdoptBy)
 }
 
 func (t *PetServiceTest) TestAdoptByPetNotFound() {
 	wantError := status.Error(codes.NotFound, "pet not found")
-	repo := &mock.RepositoryMock{}
-
-	repo.On("FindOne", t.AdoptByReq.PetId, &pet.Pet{}).Return(nil, wantError)
 
+	repo := &mock.RepositoryMock{}
 	imgSrv := new(img_mock.ServiceMock)
-	srv := NewService(repo, imgSrv)
+	adoptionSrv := new(adoptionMock.ServiceMock)
+	adoptionSrv.On("Submit", &adoptionProto.SubmitAdoptionRequest{PetId: t.AdoptByReq.PetId, UserId: t.AdoptByReq.UserId}).Return(nil, wantError)
+
+	srv := NewService(repo, imgSrv, adoptionSrv, t.LikeRepo, petUtils.DefaultAgeBands)
 
 	actual, err := srv.AdoptPet(context.Background(), t.AdoptByReq)
 
 	assert.NotNil(t.T(), err)
 	assert.Equal(t.T(), wantError, err)
 	assert.Nil(t.T(), actual)
+}
 
-	repo.AssertNotCalled(t.T(), "Update", t.AdoptByReq.PetId, t.ChangeAdoptBy)
+func (t *PetServiceTest) adminContext() context.Context {
+	return authUtils.WithIdentity(context.Background(), &authUtils.Identity{
+		UserId: uuid.NewString(),
+		Role:   userConst.ADMIN,
+	})
 }
 
-func (t *PetServiceTest) TestAdoptByUpdateError() {
-	wantError := status.Error(codes.NotFound, "pet not found")
+func (t *PetServiceTest) TestFindDeletedSuccess() {
+	want := &proto.FindAllPetResponse{
+		Pets: t.createPetsDto(t.Pets, make([][]*img_proto.Image, len(t.Pets))),
+		Metadata: &proto.FindAllPetMetaData{
+			Page:       1,
+			PageSize:   int32(len(t.Pets)),
+			Total:      int32(len(t.Pets)),
+			TotalPages: 1,
+		},
+	}
+
 	repo := &mock.RepositoryMock{}
+	repo.On("FindDeleted", int32(0), int32(0)).Return(&t.Pets, int64(len(t.Pets)), nil)
+	imgSrv := new(img_mock.ServiceMock)
 
-	repo.On("FindOne", t.AdoptByReq.PetId, &pet.Pet{}).Return(t.Pet, nil)
-	repo.On("Update", t.AdoptByReq.PetId, t.ChangeAdoptBy).Return(nil, errors.New("update error"))
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindDeleted(t.adminContext(), &proto.FindDeletedPetRequest{})
 
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *PetServiceTest) TestFindDeletedPermissionDenied() {
+	repo := &mock.RepositoryMock{}
 	imgSrv := new(img_mock.ServiceMock)
-	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(nil, errors.New("pet not found"))
 
-	srv := NewService(repo, imgSrv)
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindDeleted(context.Background(), &proto.FindDeletedPetRequest{})
 
-	actual, err := srv.AdoptPet(context.Background(), t.AdoptByReq)
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+}
+
+func (t *PetServiceTest) TestFindDeletedCanceled() {
+	var petsIn []*pet.Pet
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindDeleted", int32(0), int32(0)).Return(&petsIn, int64(0), context.Canceled)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindDeleted(t.adminContext(), &proto.FindDeletedPetRequest{})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Canceled, st.Code())
+}
+
+func (t *PetServiceTest) TestRestoreSuccess() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Restore", t.Pet.ID.String()).Return(nil)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Restore(t.adminContext(), &proto.RestorePetRequest{Id: t.Pet.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), &proto.RestorePetResponse{Success: true}, actual)
+}
+
+func (t *PetServiceTest) TestRestoreNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Restore", t.Pet.ID.String()).Return(gorm.ErrRecordNotFound)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Restore(t.adminContext(), &proto.RestorePetRequest{Id: t.Pet.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *PetServiceTest) TestFindDeletedPage() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindDeleted", int32(2), int32(1)).Return(&[]*pet.Pet{t.Pets[1]}, int64(len(t.Pets)), nil)
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindDeleted(t.adminContext(), &proto.FindDeletedPetRequest{Page: 2, PageSize: 1})
+
+	assert.Nil(t.T(), err)
+	assert.Len(t.T(), actual.Pets, 1)
+	assert.Equal(t.T(), int32(2), actual.Metadata.Page)
+	assert.Equal(t.T(), int32(len(t.Pets)), actual.Metadata.TotalPages)
+}
+
+func (t *PetServiceTest) TestPurgeSkipsPetWithUndeletedImages() {
+	failed := t.Pets[1].ID.String()
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindPurgeable", tMock.AnythingOfType("time.Time")).Return(&t.Pets, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	for _, p := range t.Pets {
+		id := p.ID.String()
+		if id == failed {
+			imgSrv.On("DeleteByPetId", id).Return(status.Error(codes.Unavailable, "Connection Timeout"))
+			continue
+		}
+		imgSrv.On("DeleteByPetId", id).Return(nil)
+		imgSrv.On("Evict", id)
+		repo.On("Purge", id).Return(nil)
+	}
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	purged, err := srv.Purge(t.adminContext(), 30*24*time.Hour)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), len(t.Pets)-1, purged)
+	repo.AssertNotCalled(t.T(), "Purge", failed)
+	imgSrv.AssertNotCalled(t.T(), "Evict", failed)
+}
+
+func (t *PetServiceTest) TestPurgePermissionDenied() {
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	purged, err := srv.Purge(context.Background(), 30*24*time.Hour)
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), 0, purged)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+	repo.AssertNotCalled(t.T(), "FindPurgeable", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestCreateScheduled() {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	unpublishAt := publishAt.Add(7 * 24 * time.Hour)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("Create", tMock.MatchedBy(func(in *pet.Pet) bool {
+		return in.PublishAt.Equal(publishAt) && in.UnpublishAt.Equal(unpublishAt)
+	})).Return(t.Pet, nil)
+
+	t.CreatePetReqMock.Pet.PublishAt = timestamppb.New(publishAt)
+	t.CreatePetReqMock.Pet.UnpublishAt = timestamppb.New(unpublishAt)
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	_, err := srv.Create(context.Background(), t.CreatePetReqMock)
+
+	assert.Nil(t.T(), err)
+}
+
+func (t *PetServiceTest) TestCreateInvalidSchedule() {
+	repo := &mock.RepositoryMock{}
+
+	t.CreatePetReqMock.Pet.PublishAt = &timestamppb.Timestamp{Nanos: -1}
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Create(context.Background(), t.CreatePetReqMock)
 
-	assert.NotNil(t.T(), err)
-	assert.Equal(t.T(), wantError, err)
 	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, status.Code(err))
+	repo.AssertNotCalled(t.T(), "Create", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestUpdateSchedule() {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	unpublishAt := publishAt.Add(7 * 24 * time.Hour)
+	t.Pet.UnpublishAt = &unpublishAt
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), []string{"is_visible", "publish_at"}, tMock.MatchedBy(func(in *pet.Pet) bool {
+		return in.PublishAt.Equal(publishAt)
+	})).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
+
+	t.UpdatePetReqMock.Pet.PublishAt = timestamppb.New(publishAt)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.UpdateMaskHeader, "isVisible,publishAt"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	_, err := srv.Update(ctx, t.UpdatePetReqMock)
+
+	assert.Nil(t.T(), err)
+}
+
+func (t *PetServiceTest) TestUpdateClearSchedule() {
+	unpublishAt := time.Now().Add(time.Hour)
+	t.Pet.UnpublishAt = &unpublishAt
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+	repo.On("Update", t.Pet.ID.String(), []string{"is_visible", "unpublish_at"}, tMock.MatchedBy(func(in *pet.Pet) bool {
+		return in.UnpublishAt == nil
+	})).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", t.Pet.ID.String()).Return(t.Images, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(petUtils.UpdateMaskHeader, "isVisible,unpublishAt"))
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	_, err := srv.Update(ctx, t.UpdatePetReqMock)
+
+	assert.Nil(t.T(), err)
+}
+
+func (t *PetServiceTest) TestUpdateUnpublishBeforePublish() {
+	publishAt := time.Now().Add(2 * time.Hour)
+	t.Pet.PublishAt = &publishAt
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.Pet.ID.String(), &pet.Pet{}).Return(t.Pet, nil)
+
+	t.UpdatePetReqMock.Pet.UnpublishAt = timestamppb.New(publishAt.Add(-time.Hour))
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Update(context.Background(), t.UpdatePetReqMock)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, status.Code(err))
+	repo.AssertNotCalled(t.T(), "Update", tMock.Anything, tMock.Anything, tMock.Anything)
+}
+
+func (t *PetServiceTest) TestApplySchedule() {
+	repo := &mock.RepositoryMock{}
+	repo.On("ApplySchedule", tMock.Anything).Return(&[]*pet.Pet{t.Pets[0], t.Pets[1]}, &[]*pet.Pet{t.Pets[2]}, nil)
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	changed, err := srv.ApplySchedule(t.adminContext())
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), 3, changed)
+}
+
+func (t *PetServiceTest) TestApplyScheduleInternalError() {
+	repo := &mock.RepositoryMock{}
+	repo.On("ApplySchedule", tMock.Anything).Return(nil, nil, errors.New("connection reset"))
+
+	srv := NewService(repo, new(img_mock.ServiceMock), new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	changed, err := srv.ApplySchedule(t.adminContext())
+
+	assert.Equal(t.T(), 0, changed)
+	assert.Equal(t.T(), codes.Internal, status.Code(err))
+}
+
+func (t *PetServiceTest) TestFindOneSetsLikes() {
+	userId := uuid.NewString()
+	petId := t.Pet.ID.String()
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", petId, &pet.Pet{}).Return(t.Pet, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetId", petId).Return(t.Images, nil)
+	likeRepo := new(likeMock.RepositoryMock)
+	likeRepo.On("CountByPetIds", []string{petId}).Return(map[string]int64{petId: 3}, nil)
+	likeRepo.On("FindLikedPetIds", userId, []string{petId}).Return([]string{petId}, nil)
+
+	ctx := authUtils.WithIdentity(context.Background(), &authUtils.Identity{UserId: userId, Role: userConst.USER})
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), likeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindOne(ctx, &proto.FindOnePetRequest{Id: petId})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), int64(3), actual.Pet.LikeCount)
+	assert.True(t.T(), actual.Pet.Liked)
+}
+
+func (t *PetServiceTest) TestFindMostLikedSuccess() {
+	ranked := []*pet.Pet{t.Pets[2], t.Pets[0], t.Pets[1]}
+	ranks := []*like.PetRank{
+		{PetID: ranked[0].ID.String(), LikeCount: 5},
+		{PetID: ranked[1].ID.String(), LikeCount: 3},
+		{PetID: ranked[2].ID.String(), LikeCount: 1},
+	}
+	petIds := t.petIds(ranked)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByIds", petIds).Return(&[]*pet.Pet{t.Pets[0], t.Pets[1], t.Pets[2]}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", petIds).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("RankPets", tMock.MatchedBy(func(query *like.RankQuery) bool {
+		return query.Type == "dog" && query.Limit == defaultRankLimit && query.Since != nil &&
+			time.Since(*query.Since) >= 7*24*time.Hour
+	})).Return(&ranks, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindMostLiked(context.Background(), &proto.FindMostLikedPetRequest{Type: "dog", Window: durationpb.New(168 * time.Hour)})
+
+	assert.Nil(t.T(), err)
+	assert.Len(t.T(), actual.Pets, len(ranked))
+	for i, p := range ranked {
+		assert.Equal(t.T(), p.ID.String(), actual.Pets[i].Id)
+	}
+}
+
+func (t *PetServiceTest) TestFindMostLikedDeletedSinceRanking() {
+	ranks := []*like.PetRank{
+		{PetID: t.Pets[0].ID.String(), LikeCount: 5},
+		{PetID: t.Pets[1].ID.String(), LikeCount: 3},
+	}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByIds", t.petIds(t.Pets[:2])).Return(&[]*pet.Pet{t.Pets[1]}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", []string{t.Pets[1].ID.String()}).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("RankPets", &like.RankQuery{Limit: defaultRankLimit}).Return(&ranks, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindMostLiked(context.Background(), &proto.FindMostLikedPetRequest{})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), []string{t.Pets[1].ID.String()}, t.dtoIds(actual.Pets))
+	imgSrv.AssertExpectations(t.T())
+}
+
+func (t *PetServiceTest) TestFindMostLikedNoLikes() {
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", []string{}).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("RankPets", &like.RankQuery{Limit: maxRankLimit}).Return(&[]*like.PetRank{}, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindMostLiked(context.Background(), &proto.FindMostLikedPetRequest{Limit: 1000})
+
+	assert.Nil(t.T(), err)
+	assert.Empty(t.T(), actual.Pets)
+	repo.AssertNotCalled(t.T(), "FindByIds", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestFindMostLikedInvalidWindow() {
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.FindMostLiked(context.Background(), &proto.FindMostLikedPetRequest{Window: durationpb.New(-time.Hour)})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.InvalidArgument, st.Code())
+	t.LikeRepo.AssertNotCalled(t.T(), "RankPets", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestRecommendByAttributes() {
+	userId := uuid.NewString()
+	birthdate := yearsAgo(3)
+	liked := t.recommendablePet("dog", "black", birthdate)
+	blackDog := t.recommendablePet("dog", "black", birthdate)
+	whiteDog := t.recommendablePet("dog", "white", birthdate)
+	whiteCat := t.recommendablePet("cat", "white", birthdate)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindAdoptable", recommendationPoolSize).Return(&[]*pet.Pet{whiteCat, liked, whiteDog, blackDog}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", tMock.Anything).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("FindLikedPets", &like.LikedPetsQuery{UserID: userId, PageSize: recommendationProfileSize}).Return(&[]*like.Like{{Pet: liked}}, int64(1), nil)
+	t.LikeRepo.On("FindCoLikedPets", userId, recommendationPoolSize).Return(&[]*like.PetRank{}, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Recommend(t.adminContext(), &proto.RecommendPetRequest{UserId: userId})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds([]*pet.Pet{blackDog, whiteDog, whiteCat}), t.dtoIds(actual.Pets))
+}
+
+func (t *PetServiceTest) TestRecommendByCoLikes() {
+	userId := uuid.NewString()
+	birthdate := yearsAgo(3)
+	liked := t.recommendablePet("dog", "black", birthdate)
+	blackDog := t.recommendablePet("dog", "black", birthdate)
+	whiteCat := t.recommendablePet("cat", "white", birthdate)
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByIds", []string{whiteCat.ID.String()}).Return(&[]*pet.Pet{whiteCat}, nil)
+	repo.On("FindAdoptable", recommendationPoolSize).Return(&[]*pet.Pet{blackDog, whiteCat}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", tMock.Anything).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("FindLikedPets", &like.LikedPetsQuery{UserID: userId, PageSize: recommendationProfileSize}).Return(&[]*like.Like{{Pet: liked}}, int64(1), nil)
+	t.LikeRepo.On("FindCoLikedPets", userId, recommendationPoolSize).Return(&[]*like.PetRank{{PetID: whiteCat.ID.String(), LikeCount: 4}}, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Recommend(t.adminContext(), &proto.RecommendPetRequest{UserId: userId})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds([]*pet.Pet{whiteCat, blackDog}), t.dtoIds(actual.Pets))
+}
+
+func (t *PetServiceTest) TestRecommendCoLikesFillLimit() {
+	userId := uuid.NewString()
+	birthdate := yearsAgo(3)
+	liked := t.recommendablePet("dog", "black", birthdate)
+	blackDog := t.recommendablePet("dog", "black", birthdate)
+	whiteCat := t.recommendablePet("cat", "white", birthdate)
+	hidden := t.recommendablePet("dog", "black", birthdate)
+	hidden.IsVisible = false
+	ranks := []*like.PetRank{
+		{PetID: hidden.ID.String(), LikeCount: 5},
+		{PetID: whiteCat.ID.String(), LikeCount: 4},
+		{PetID: blackDog.ID.String(), LikeCount: 1},
+	}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByIds", t.petIds([]*pet.Pet{hidden, whiteCat, blackDog})).Return(&[]*pet.Pet{blackDog, hidden, whiteCat}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", tMock.Anything).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("FindLikedPets", &like.LikedPetsQuery{UserID: userId, PageSize: recommendationProfileSize}).Return(&[]*like.Like{{Pet: liked}}, int64(1), nil)
+	t.LikeRepo.On("FindCoLikedPets", userId, recommendationPoolSize).Return(&ranks, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Recommend(t.adminContext(), &proto.RecommendPetRequest{UserId: userId, Limit: 2})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), t.petIds([]*pet.Pet{whiteCat, blackDog}), t.dtoIds(actual.Pets))
+	repo.AssertNotCalled(t.T(), "FindAdoptable", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestRecommendNewUser() {
+	userId := uuid.NewString()
+	popular := t.Pets[1]
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByIds", []string{popular.ID.String()}).Return(&[]*pet.Pet{popular}, nil)
+	imgSrv := new(img_mock.ServiceMock)
+	imgSrv.On("FindByPetIds", tMock.Anything).Return(map[string][]*img_proto.Image{})
+	t.LikeRepo.On("FindLikedPets", &like.LikedPetsQuery{UserID: userId, PageSize: recommendationProfileSize}).Return(&[]*like.Like{}, int64(0), nil)
+	t.LikeRepo.On("RankPets", &like.RankQuery{Limit: 5}).Return(&[]*like.PetRank{{PetID: popular.ID.String(), LikeCount: 9}}, nil)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	actual, err := srv.Recommend(t.adminContext(), &proto.RecommendPetRequest{UserId: userId, Limit: 5})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), []string{popular.ID.String()}, t.dtoIds(actual.Pets))
+	repo.AssertNotCalled(t.T(), "FindAdoptable", tMock.Anything)
+}
+
+func (t *PetServiceTest) TestRecommendPermissionDenied() {
+	repo := &mock.RepositoryMock{}
+	imgSrv := new(img_mock.ServiceMock)
+
+	srv := NewService(repo, imgSrv, new(adoptionMock.ServiceMock), t.LikeRepo, petUtils.DefaultAgeBands)
+	ctx := authUtils.WithIdentity(context.Background(), &authUtils.Identity{UserId: uuid.NewString(), Role: userConst.USER})
+	actual, err := srv.Recommend(ctx, &proto.RecommendPetRequest{UserId: uuid.NewString()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+	t.LikeRepo.AssertNotCalled(t.T(), "FindLikedPets", tMock.Anything)
+}
+
+func (t *PetServiceTest) recommendablePet(petType string, color string, birthdate *time.Time) *pet.Pet {
+	return &pet.Pet{
+		Base:      model.Base{ID: uuid.New()},
+		Type:      petType,
+		Color:     color,
+		Birthdate: birthdate,
+		Gender:    petConst.MALE,
+		Status:    petConst.FINDHOME,
+		IsVisible: true,
+	}
+}
+
+func (t *PetServiceTest) dtoIds(in []*proto.Pet) []string {
+	var result []string
+	for _, p := range in {
+		result = append(result, p.Id)
+	}
+	return result
+}
+
+// yearsAgo returns the date the given number of years ago, as a birthdate is stored
+func yearsAgo(years int) *time.Time {
+	now := time.Now()
+	date := time.Date(now.Year()-years, now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
 }
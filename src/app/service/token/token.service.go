@@ -0,0 +1,108 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+)
+
+const (
+	defaultAccessTTL  = 15 * time.Minute
+	defaultRefreshTTL = 7 * 24 * time.Hour
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Role      userConst.Role      `json:"role"`
+	TokenType authConst.TokenType `json:"token_type"`
+}
+
+type Service struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewService(conf *config.Jwt) *Service {
+	s := &Service{
+		secret:     []byte(conf.Secret),
+		issuer:     conf.Issuer,
+		accessTTL:  defaultAccessTTL,
+		refreshTTL: defaultRefreshTTL,
+	}
+
+	if conf.AccessTTL > 0 {
+		s.accessTTL = time.Duration(conf.AccessTTL) * time.Second
+	}
+	if conf.RefreshTTL > 0 {
+		s.refreshTTL = time.Duration(conf.RefreshTTL) * time.Second
+	}
+
+	return s
+}
+
+func (s *Service) CreateCredential(in *user.User) (*proto.Credential, error) {
+	accessToken, err := s.sign(in, authConst.ACCESS_TOKEN, s.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := s.sign(in, authConst.REFRESH_TOKEN, s.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Credential{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int32(s.accessTTL.Seconds()),
+	}, nil
+}
+
+// Validate checks the signature, expiry and type of the token and returns the identity it was issued to
+func (s *Service) Validate(token string, tokenType authConst.TokenType) (*authUtils.Identity, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(s.issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if claims.TokenType != tokenType || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+
+	return &authUtils.Identity{
+		UserId: claims.Subject,
+		Role:   claims.Role,
+	}, nil
+}
+
+func (s *Service) sign(in *user.User, tokenType authConst.TokenType, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Subject:   in.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Role:      in.Role,
+		TokenType: tokenType,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
@@ -0,0 +1,95 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenServiceTest struct {
+	suite.Suite
+	conf *config.Jwt
+	User *user.User
+}
+
+func TestTokenService(t *testing.T) {
+	suite.Run(t, new(TokenServiceTest))
+}
+
+func (t *TokenServiceTest) SetupTest() {
+	t.conf = &config.Jwt{
+		Secret:     faker.Password(),
+		Issuer:     "johnjud-backend",
+		AccessTTL:  60,
+		RefreshTTL: 120,
+	}
+
+	t.User = &user.User{
+		Base: model.Base{
+			ID: uuid.New(),
+		},
+		Email: faker.Email(),
+		Role:  userConst.ADMIN,
+	}
+}
+
+func (t *TokenServiceTest) TestCreateAndValidateSuccess() {
+	want := &authUtils.Identity{UserId: t.User.ID.String(), Role: userConst.ADMIN}
+
+	srv := NewService(t.conf)
+	credential, err := srv.CreateCredential(t.User)
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), int32(60), credential.ExpiresIn)
+
+	actual, err := srv.Validate(credential.AccessToken, authConst.ACCESS_TOKEN)
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+
+	actual, err = srv.Validate(credential.RefreshToken, authConst.REFRESH_TOKEN)
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *TokenServiceTest) TestValidateWrongTokenType() {
+	srv := NewService(t.conf)
+	credential, err := srv.CreateCredential(t.User)
+	assert.Nil(t.T(), err)
+
+	actual, err := srv.Validate(credential.RefreshToken, authConst.ACCESS_TOKEN)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrInvalidToken, err)
+}
+
+func (t *TokenServiceTest) TestValidateWrongSecret() {
+	credential, err := NewService(t.conf).CreateCredential(t.User)
+	assert.Nil(t.T(), err)
+
+	srv := NewService(&config.Jwt{Secret: "wrong" + t.conf.Secret, Issuer: t.conf.Issuer})
+	actual, err := srv.Validate(credential.AccessToken, authConst.ACCESS_TOKEN)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrInvalidToken, err)
+}
+
+func (t *TokenServiceTest) TestValidateExpired() {
+	srv := NewService(t.conf)
+	srv.accessTTL = -time.Minute
+	credential, err := srv.CreateCredential(t.User)
+	assert.Nil(t.T(), err)
+
+	actual, err := srv.Validate(credential.AccessToken, authConst.ACCESS_TOKEN)
+
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), ErrInvalidToken, err)
+}
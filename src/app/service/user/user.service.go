@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	dbUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/database"
+	userUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/user/v1"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	proto.UnimplementedUserServiceServer
+	repository IRepository
+}
+
+type IRepository interface {
+	FindOne(context.Context, string, *user.User) error
+	FindByEmail(context.Context, string, *user.User) error
+	Create(context.Context, *user.User) error
+	Update(context.Context, string, *user.User) error
+	Delete(context.Context, string) error
+}
+
+func NewService(repository IRepository) *Service {
+	return &Service{repository: repository}
+}
+
+func (s *Service) FindOne(ctx context.Context, req *proto.FindOneUserRequest) (*proto.FindOneUserResponse, error) {
+	if !authUtils.CanActAs(ctx, req.Id) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	raw := user.User{}
+
+	err := s.repository.FindOne(ctx, req.Id, &raw)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	return &proto.FindOneUserResponse{User: userUtils.RawToDto(&raw)}, nil
+}
+
+func (s *Service) Update(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
+	if !authUtils.CanActAs(ctx, req.Id) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	raw := &user.User{
+		Email:     userUtils.NormalizeEmail(req.Email),
+		Firstname: req.Firstname,
+		Lastname:  req.Lastname,
+	}
+
+	if raw.Email != "" {
+		existing := user.User{}
+		err := s.repository.FindByEmail(ctx, raw.Email, &existing)
+		if err == nil && existing.ID.String() != req.Id {
+			return nil, status.Error(codes.AlreadyExists, "email is already in use")
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dbUtils.StatusError(ctx, err, "user")
+		}
+	}
+
+	if req.Password != "" {
+		hash, err := userUtils.HashPassword(req.Password)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str("service", "user").Str("module", "update").Msg("Error while hashing password")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		raw.Password = hash
+	}
+
+	err := s.repository.Update(ctx, req.Id, raw)
+	if dbUtils.IsDuplicate(err) {
+		// the email was taken concurrently since the lookup above
+		return nil, status.Error(codes.AlreadyExists, "email is already in use")
+	}
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	return &proto.UpdateUserResponse{User: userUtils.RawToDto(raw)}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
+	if !authUtils.CanActAs(ctx, req.Id) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	err := s.repository.Delete(ctx, req.Id)
+	if err != nil {
+		return nil, dbUtils.StatusError(ctx, err, "user")
+	}
+
+	return &proto.DeleteUserResponse{Success: true}, nil
+}
@@ -0,0 +1,219 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bxcodec/faker/v3"
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	userUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/user"
+	userConst "github.com/isd-sgcu/johnjud-backend/src/constant/user"
+	mock "github.com/isd-sgcu/johnjud-backend/src/mocks/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/user/v1"
+	"github.com/stretchr/testify/assert"
+	tMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UserServiceTest struct {
+	suite.Suite
+	User    *user.User
+	UserDto *proto.User
+	ctx     context.Context
+}
+
+func TestUserService(t *testing.T) {
+	suite.Run(t, new(UserServiceTest))
+}
+
+func (t *UserServiceTest) SetupTest() {
+	t.User = &user.User{
+		Base: model.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Time{},
+			UpdatedAt: time.Time{},
+			DeletedAt: gorm.DeletedAt{},
+		},
+		Email:     strings.ToLower(faker.Email()),
+		Password:  faker.Password(),
+		Firstname: faker.FirstName(),
+		Lastname:  faker.LastName(),
+		Role:      userConst.USER,
+	}
+
+	t.ctx = authUtils.WithIdentity(context.Background(), &authUtils.Identity{
+		UserId: t.User.ID.String(),
+		Role:   t.User.Role,
+	})
+
+	t.UserDto = &proto.User{
+		Id:        t.User.ID.String(),
+		Email:     t.User.Email,
+		Firstname: t.User.Firstname,
+		Lastname:  t.User.Lastname,
+		Role:      string(t.User.Role),
+	}
+}
+
+func (t *UserServiceTest) TestFindOneSuccess() {
+	want := &proto.FindOneUserResponse{User: t.UserDto}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.User.ID.String(), &user.User{}).Return(t.User, nil)
+
+	srv := NewService(repo)
+	actual, err := srv.FindOne(t.ctx, &proto.FindOneUserRequest{Id: t.User.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *UserServiceTest) TestFindOneNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.User.ID.String(), &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo)
+	actual, err := srv.FindOne(t.ctx, &proto.FindOneUserRequest{Id: t.User.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *UserServiceTest) TestFindOneInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindOne", t.User.ID.String(), &user.User{}).Return(nil, errors.New("something wrong"))
+
+	srv := NewService(repo)
+	actual, err := srv.FindOne(t.ctx, &proto.FindOneUserRequest{Id: t.User.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, st.Code())
+}
+
+func (t *UserServiceTest) TestFindOnePermissionDenied() {
+	repo := &mock.RepositoryMock{}
+
+	srv := NewService(repo)
+	actual, err := srv.FindOne(t.ctx, &proto.FindOneUserRequest{Id: uuid.NewString()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.PermissionDenied, st.Code())
+	repo.AssertNotCalled(t.T(), "FindOne", tMock.Anything, tMock.Anything)
+}
+
+func (t *UserServiceTest) TestUpdateSuccess() {
+	want := &proto.UpdateUserResponse{User: t.UserDto}
+	password := faker.Password()
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(t.User, nil)
+	repo.On("Update", t.User.ID.String(), tMock.MatchedBy(func(in *user.User) bool {
+		return in.Email == t.User.Email && userUtils.ComparePassword(in.Password, password)
+	})).Return(t.User, nil)
+
+	srv := NewService(repo)
+	actual, err := srv.Update(t.ctx, &proto.UpdateUserRequest{
+		Id:        t.User.ID.String(),
+		Email:     t.User.Email,
+		Password:  password,
+		Firstname: t.User.Firstname,
+		Lastname:  t.User.Lastname,
+	})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *UserServiceTest) TestUpdateDuplicateEmail() {
+	other := *t.User
+	other.ID = uuid.New()
+
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(&other, nil)
+
+	srv := NewService(repo)
+	actual, err := srv.Update(t.ctx, &proto.UpdateUserRequest{
+		Id:    t.User.ID.String(),
+		Email: t.User.Email,
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, st.Code())
+	repo.AssertNotCalled(t.T(), "Update", tMock.Anything, tMock.Anything)
+}
+
+func (t *UserServiceTest) TestUpdateDuplicateEmailRace() {
+	repo := &mock.RepositoryMock{}
+	repo.On("FindByEmail", t.User.Email, &user.User{}).Return(nil, gorm.ErrRecordNotFound)
+	repo.On("Update", t.User.ID.String(), tMock.Anything).Return(nil, gorm.ErrDuplicatedKey)
+
+	srv := NewService(repo)
+	actual, err := srv.Update(t.ctx, &proto.UpdateUserRequest{
+		Id:    t.User.ID.String(),
+		Email: t.User.Email,
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.AlreadyExists, st.Code())
+}
+
+func (t *UserServiceTest) TestUpdateNotFound() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Update", t.User.ID.String(), tMock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+	srv := NewService(repo)
+	actual, err := srv.Update(t.ctx, &proto.UpdateUserRequest{
+		Id:        t.User.ID.String(),
+		Firstname: t.User.Firstname,
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.NotFound, st.Code())
+}
+
+func (t *UserServiceTest) TestDeleteSuccess() {
+	want := &proto.DeleteUserResponse{Success: true}
+
+	repo := &mock.RepositoryMock{}
+	repo.On("Delete", t.User.ID.String()).Return(nil)
+
+	srv := NewService(repo)
+	actual, err := srv.Delete(t.ctx, &proto.DeleteUserRequest{Id: t.User.ID.String()})
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *UserServiceTest) TestDeleteInternalErr() {
+	repo := &mock.RepositoryMock{}
+	repo.On("Delete", t.User.ID.String()).Return(errors.New("something wrong"))
+
+	srv := NewService(repo)
+	actual, err := srv.Delete(t.ctx, &proto.DeleteUserRequest{Id: t.User.ID.String()})
+
+	st, ok := status.FromError(err)
+	assert.True(t.T(), ok)
+	assert.Nil(t.T(), actual)
+	assert.Equal(t.T(), codes.Internal, st.Code())
+}
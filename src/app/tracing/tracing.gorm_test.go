@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type GormPluginTest struct {
+	suite.Suite
+	exporter *tracetest.InMemoryExporter
+	provider *sdktrace.TracerProvider
+	db       *gorm.DB
+}
+
+func TestGormPlugin(t *testing.T) {
+	suite.Run(t, new(GormPluginTest))
+}
+
+func (t *GormPluginTest) SetupTest() {
+	t.exporter = tracetest.NewInMemoryExporter()
+	t.provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(t.exporter))
+
+	// a dry run builds the statements and runs the callbacks without a database
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.Nil(t.T(), err)
+	assert.Nil(t.T(), db.Use(NewGormPlugin(t.provider)))
+	t.db = db
+}
+
+func (t *GormPluginTest) TestQuerySpan() {
+	ctx, parent := t.provider.Tracer("test").Start(context.Background(), "FindOne")
+
+	var result pet.Pet
+	t.db.WithContext(ctx).First(&result, "id = ?", "3b2d4f0e-0000-0000-0000-000000000000")
+	parent.End()
+
+	spans := t.exporter.GetSpans()
+	assert.Len(t.T(), spans, 2)
+
+	query := spans[0]
+	assert.Equal(t.T(), "gorm.query", query.Name)
+	assert.Equal(t.T(), parent.SpanContext().SpanID(), query.Parent.SpanID())
+	assert.Equal(t.T(), parent.SpanContext().TraceID(), query.SpanContext.TraceID())
+	assert.Contains(t.T(), query.Attributes, attribute.String("db.sql.table", "pets"))
+	for _, attr := range query.Attributes {
+		if attr.Key == "db.statement" {
+			assert.NotContains(t.T(), attr.Value.AsString(), "3b2d4f0e")
+		}
+	}
+}
+
+func (t *GormPluginTest) TestRootSpanWithoutParent() {
+	t.db.Exec("SELECT 1")
+
+	spans := t.exporter.GetSpans()
+	assert.Len(t.T(), spans, 1)
+	assert.Equal(t.T(), "gorm.raw", spans[0].Name)
+	assert.False(t.T(), spans[0].Parent.IsValid())
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const serviceName = "johnjud-backend"
+
+const (
+	OTLP   = "otlp"
+	STDOUT = "stdout"
+	NONE   = "none"
+)
+
+// Propagator reads and writes the W3C trace context and baggage, the headers set by the gateway
+var Propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// NewExporter creates the span exporter chosen by conf.Exporter, it returns nil when tracing is disabled
+func NewExporter(ctx context.Context, conf *config.Tracing) (sdktrace.SpanExporter, error) {
+	switch conf.Exporter {
+	case OTLP:
+		var opts []otlptracegrpc.Option
+		if conf.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(conf.Endpoint))
+		}
+		if conf.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case STDOUT:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case NONE, "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %v", conf.Exporter)
+	}
+}
+
+// NewProvider creates a tracer provider sampling the requests not traced by the caller at conf.SampleRatio
+func NewProvider(exporter sdktrace.SpanExporter, conf *config.Tracing) *sdktrace.TracerProvider {
+	ratio := conf.SampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+}
+
+// ServerOption traces the requests handled by the server, continuing the trace of the caller
+func ServerOption(provider trace.TracerProvider) grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(Propagator),
+	))
+}
+
+// DialOption traces the calls made through the client connection and passes the trace on to the called service
+func DialOption(provider trace.TracerProvider) grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(Propagator),
+	))
+}
@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type ProviderTest struct {
+	suite.Suite
+	exporter *tracetest.InMemoryExporter
+	provider *sdktrace.TracerProvider
+	lis      *bufconn.Listener
+	server   *grpc.Server
+	client   grpc_health_v1.HealthClient
+	conns    []*grpc.ClientConn
+}
+
+func TestProvider(t *testing.T) {
+	suite.Run(t, new(ProviderTest))
+}
+
+func (t *ProviderTest) SetupTest() {
+	t.exporter = tracetest.NewInMemoryExporter()
+	t.provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(t.exporter))
+
+	t.lis = bufconn.Listen(1024 * 1024)
+	t.server = grpc.NewServer(ServerOption(t.provider))
+	grpc_health_v1.RegisterHealthServer(t.server, health.NewServer())
+	go t.server.Serve(t.lis)
+
+	t.client = t.dial()
+}
+
+func (t *ProviderTest) dial(opts ...grpc.DialOption) grpc_health_v1.HealthClient {
+	opts = append(opts,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return t.lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	conn, err := grpc.Dial("bufnet", opts...)
+	assert.Nil(t.T(), err)
+	t.conns = append(t.conns, conn)
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+// spans waits for the server to end its span, which may happen after the response reached the client
+func (t *ProviderTest) spans(count int) tracetest.SpanStubs {
+	assert.Eventually(t.T(), func() bool {
+		return len(t.exporter.GetSpans()) >= count
+	}, time.Second, 5*time.Millisecond)
+
+	return t.exporter.GetSpans()
+}
+
+func (t *ProviderTest) TearDownTest() {
+	for _, conn := range t.conns {
+		conn.Close()
+	}
+	t.server.Stop()
+}
+
+func (t *ProviderTest) TestContinuesTraceOfGateway() {
+	traceId := "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "traceparent", "00-"+traceId+"-00f067aa0ba902b7-01")
+
+	_, err := t.client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.Nil(t.T(), err)
+
+	spans := t.spans(1)
+	assert.Len(t.T(), spans, 1)
+	assert.Equal(t.T(), traceId, spans[0].SpanContext.TraceID().String())
+	assert.Equal(t.T(), "00f067aa0ba902b7", spans[0].Parent.SpanID().String())
+	assert.True(t.T(), spans[0].Parent.IsRemote())
+	assert.Equal(t.T(), trace.SpanKindServer, spans[0].SpanKind)
+}
+
+func (t *ProviderTest) TestNewRootTraceWithoutGateway() {
+	_, err := t.client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.Nil(t.T(), err)
+
+	spans := t.spans(1)
+	assert.Len(t.T(), spans, 1)
+	assert.False(t.T(), spans[0].Parent.IsValid())
+}
+
+func (t *ProviderTest) TestPassesTraceOnToCalledService() {
+	client := t.dial(DialOption(t.provider))
+	ctx, parent := t.provider.Tracer("test").Start(context.Background(), "FindAll")
+
+	_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	parent.End()
+	assert.Nil(t.T(), err)
+
+	spans := t.spans(3)
+	assert.Len(t.T(), spans, 3)
+
+	byKind := make(map[trace.SpanKind]tracetest.SpanStub)
+	for _, span := range spans {
+		byKind[span.SpanKind] = span
+	}
+	clientSpan, serverSpan := byKind[trace.SpanKindClient], byKind[trace.SpanKindServer]
+	assert.Equal(t.T(), parent.SpanContext().SpanID(), clientSpan.Parent.SpanID())
+	assert.Equal(t.T(), clientSpan.SpanContext.SpanID(), serverSpan.Parent.SpanID())
+	assert.Equal(t.T(), parent.SpanContext().TraceID(), serverSpan.SpanContext.TraceID())
+}
+
+func (t *ProviderTest) TestNewExporter() {
+	exporter, err := NewExporter(context.Background(), &config.Tracing{Exporter: NONE})
+	assert.Nil(t.T(), err)
+	assert.Nil(t.T(), exporter)
+
+	exporter, err = NewExporter(context.Background(), &config.Tracing{Exporter: STDOUT})
+	assert.Nil(t.T(), err)
+	assert.NotNil(t.T(), exporter)
+
+	_, err = NewExporter(context.Background(), &config.Tracing{Exporter: "jaeger"})
+	assert.NotNil(t.T(), err)
+}
@@ -0,0 +1,34 @@
+package adoption
+
+import (
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+)
+
+func RawToDtoList(in []*adoption.Adoption) []*proto.Adoption {
+	var result []*proto.Adoption
+	for _, a := range in {
+		result = append(result, RawToDto(a))
+	}
+	return result
+}
+
+func RawToDto(in *adoption.Adoption) *proto.Adoption {
+	return &proto.Adoption{
+		Id:         in.ID.String(),
+		PetId:      idToString(in.PetID),
+		UserId:     idToString(in.UserID),
+		Status:     string(in.Status),
+		Message:    in.Message,
+		ReviewedBy: idToString(in.ReviewedBy),
+		ReviewNote: in.ReviewNote,
+	}
+}
+
+func idToString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/isd-sgcu/johnjud-backend/src/constant/user"
+)
+
+type Identity struct {
+	UserId string
+	Role   user.Role
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// WithSystemIdentity returns ctx for the background jobs, which act on behalf of no user but need admin rights
+func WithSystemIdentity(ctx context.Context) context.Context {
+	return WithIdentity(ctx, &Identity{Role: user.ADMIN})
+}
+
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+func IsAdmin(ctx context.Context) bool {
+	identity, ok := IdentityFromContext(ctx)
+	return ok && identity.Role == user.ADMIN
+}
+
+// CanActAs reports whether the caller is the given user or an admin
+func CanActAs(ctx context.Context, userId string) bool {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return false
+	}
+	return identity.Role == user.ADMIN || identity.UserId == userId
+}
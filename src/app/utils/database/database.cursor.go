@@ -0,0 +1,89 @@
+package database
+
+import (
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SortKey orders a listing by an expression then by id so ties keep their order, items without a key come last
+type SortKey struct {
+	Name string
+	Expr string
+	Vars []interface{}
+	Type string
+	Desc bool
+	ID   string
+}
+
+// Order orders a listing by the key
+func (k SortKey) Order(tx *gorm.DB) *gorm.DB {
+	direction := ""
+	if k.Desc {
+		direction = " DESC"
+	}
+
+	return tx.Clauses(clause.OrderBy{Expression: clause.Expr{
+		SQL:                k.Expr + direction + " NULLS LAST, " + k.ID,
+		Vars:               k.Vars,
+		WithoutParentheses: true,
+	}})
+}
+
+// After keeps the items after the cursor, a cursor taken in another order adds model.ErrInvalidCursor to tx
+func (k SortKey) After(cursor *model.Cursor) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if cursor == nil {
+			return tx
+		}
+		if cursor.Sort != k.Name {
+			_ = tx.AddError(model.ErrInvalidCursor)
+			return tx
+		}
+
+		if cursor.Key == nil {
+			return tx.Where(clause.Expr{
+				SQL:  "(" + k.Expr + " IS NULL AND " + k.ID + " > ?)",
+				Vars: append(k.vars(), cursor.ID),
+			})
+		}
+
+		operator := " > "
+		if k.Desc {
+			operator = " < "
+		}
+		key := "CAST(? AS " + k.Type + ")"
+
+		var vars []interface{}
+		vars = append(vars, k.vars()...)
+		vars = append(vars, *cursor.Key)
+		vars = append(vars, k.vars()...)
+		vars = append(vars, k.vars()...)
+		vars = append(vars, *cursor.Key, cursor.ID)
+
+		return tx.Where(clause.Expr{
+			SQL: "(" + k.Expr + operator + key + " OR " + k.Expr + " IS NULL OR (" +
+				k.Expr + " = " + key + " AND " + k.ID + " > ?))",
+			Vars: vars,
+		})
+	}
+}
+
+// Cursor returns the cursor of the item with the given id
+func (k SortKey) Cursor(tx *gorm.DB, id string, result *model.Cursor) error {
+	var row struct {
+		Key *string
+	}
+
+	err := tx.Select("("+k.Expr+")::text AS key", k.Vars...).Where(k.ID+" = ?", id).Take(&row).Error
+	if err != nil {
+		return err
+	}
+
+	*result = model.Cursor{Sort: k.Name, Key: row.Key, ID: id}
+	return nil
+}
+
+func (k SortKey) vars() []interface{} {
+	return append([]interface{}{}, k.Vars...)
+}
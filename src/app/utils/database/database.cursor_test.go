@@ -0,0 +1,120 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID   string
+	Name string
+}
+
+type SortKeyTest struct {
+	suite.Suite
+	db  *gorm.DB
+	key SortKey
+}
+
+func TestSortKey(t *testing.T) {
+	suite.Run(t, new(SortKeyTest))
+}
+
+func (t *SortKeyTest) SetupTest() {
+	// a dry run builds the statements without a database
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.Nil(t.T(), err)
+	t.db = db
+
+	t.key = SortKey{Name: "score", Expr: "similarity(name, ?)", Vars: []interface{}{"milo"}, Type: "real", Desc: true, ID: "id"}
+}
+
+func (t *SortKeyTest) find(scopes ...func(*gorm.DB) *gorm.DB) *gorm.DB {
+	var result []*item
+	return t.db.Model(&item{}).Scopes(scopes...).Find(&result)
+}
+
+func (t *SortKeyTest) TestOrder() {
+	stmt := t.find(t.key.Order).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items" ORDER BY similarity(name, $1) DESC NULLS LAST, id`, stmt.SQL.String())
+	assert.Equal(t.T(), []interface{}{"milo"}, stmt.Vars)
+}
+
+func (t *SortKeyTest) TestOrderAscending() {
+	key := SortKey{Name: "name", Expr: "lower(name)", Type: "text", ID: "id"}
+
+	stmt := t.find(key.Order).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items" ORDER BY lower(name) NULLS LAST, id`, stmt.SQL.String())
+	assert.Empty(t.T(), stmt.Vars)
+}
+
+func (t *SortKeyTest) TestAfterWithoutCursor() {
+	stmt := t.find(t.key.After(nil)).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items"`, stmt.SQL.String())
+}
+
+func (t *SortKeyTest) TestAfter() {
+	key := "0.5"
+	id := "3b2d4f0e-0000-0000-0000-000000000000"
+
+	stmt := t.find(t.key.After(&model.Cursor{Sort: "score", Key: &key, ID: id})).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items" WHERE (similarity(name, $1) < CAST($2 AS real)`+
+		` OR similarity(name, $3) IS NULL`+
+		` OR (similarity(name, $4) = CAST($5 AS real) AND id > $6))`, stmt.SQL.String())
+	assert.Equal(t.T(), []interface{}{"milo", key, "milo", "milo", key, id}, stmt.Vars)
+}
+
+func (t *SortKeyTest) TestAfterAscending() {
+	key := "milo"
+	id := "3b2d4f0e-0000-0000-0000-000000000000"
+	sortKey := SortKey{Name: "name", Expr: "lower(name)", Type: "text", ID: "id"}
+
+	stmt := t.find(sortKey.After(&model.Cursor{Sort: "name", Key: &key, ID: id})).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items" WHERE (lower(name) > CAST($1 AS text)`+
+		` OR lower(name) IS NULL`+
+		` OR (lower(name) = CAST($2 AS text) AND id > $3))`, stmt.SQL.String())
+	assert.Equal(t.T(), []interface{}{key, key, id}, stmt.Vars)
+}
+
+func (t *SortKeyTest) TestAfterWithoutKey() {
+	id := "3b2d4f0e-0000-0000-0000-000000000000"
+
+	stmt := t.find(t.key.After(&model.Cursor{Sort: "score", ID: id})).Statement
+
+	assert.Equal(t.T(), `SELECT * FROM "items" WHERE (similarity(name, $1) IS NULL AND id > $2)`, stmt.SQL.String())
+	assert.Equal(t.T(), []interface{}{"milo", id}, stmt.Vars)
+}
+
+func (t *SortKeyTest) TestAfterOtherSort() {
+	tx := t.find(t.key.After(&model.Cursor{Sort: "name", ID: "3b2d4f0e-0000-0000-0000-000000000000"}))
+
+	assert.ErrorIs(t.T(), tx.Error, model.ErrInvalidCursor)
+}
+
+func (t *SortKeyTest) TestCursor() {
+	var sql string
+	var vars []interface{}
+	err := t.db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+	})
+	assert.Nil(t.T(), err)
+
+	id := "3b2d4f0e-0000-0000-0000-000000000000"
+	var cursor model.Cursor
+	err = t.key.Cursor(t.db.Model(&item{}), id, &cursor)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), `SELECT (similarity(name, $1))::text AS key FROM "items" WHERE id = $2 LIMIT 1`, sql)
+	assert.Equal(t.T(), []interface{}{"milo", id}, vars)
+	assert.Equal(t.T(), model.Cursor{Sort: "score", ID: id}, cursor)
+}
@@ -0,0 +1,18 @@
+package database
+
+import "gorm.io/gorm"
+
+// Page limits a query to the page plus extra rows past it, ok is false when the page is known to be empty
+func Page(page int32, pageSize int32, extra int) (scope func(*gorm.DB) *gorm.DB, ok bool) {
+	if pageSize <= 0 {
+		return func(tx *gorm.DB) *gorm.DB { return tx }, page <= 1
+	}
+
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Limit(int(pageSize) + extra)
+		if page > 1 {
+			tx = tx.Offset(int((page - 1) * pageSize))
+		}
+		return tx
+	}, true
+}
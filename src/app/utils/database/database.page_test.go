@@ -0,0 +1,57 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type PageTest struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func TestPage(t *testing.T) {
+	suite.Run(t, new(PageTest))
+}
+
+func (t *PageTest) SetupTest() {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.Nil(t.T(), err)
+	t.db = db
+}
+
+func (t *PageTest) find(scope func(*gorm.DB) *gorm.DB) string {
+	var result []*item
+	return t.db.Model(&item{}).Scopes(scope).Find(&result).Statement.SQL.String()
+}
+
+func (t *PageTest) TestFirstPage() {
+	scope, ok := Page(1, 10, 1)
+
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), `SELECT * FROM "items" LIMIT 11`, t.find(scope))
+}
+
+func (t *PageTest) TestLaterPage() {
+	scope, ok := Page(3, 10, 0)
+
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), `SELECT * FROM "items" LIMIT 10 OFFSET 20`, t.find(scope))
+}
+
+func (t *PageTest) TestWithoutPageSize() {
+	scope, ok := Page(1, 0, 1)
+
+	assert.True(t.T(), ok)
+	assert.Equal(t.T(), `SELECT * FROM "items"`, t.find(scope))
+}
+
+func (t *PageTest) TestLaterPageWithoutPageSize() {
+	_, ok := Page(2, 0, 0)
+
+	assert.False(t.T(), ok)
+}
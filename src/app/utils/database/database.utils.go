@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	uniqueViolation           = "23505"
+	foreignKeyViolation       = "23503"
+	invalidTextRepresentation = "22P02"
+)
+
+// StatusError maps a repository error to a gRPC status error, logging the errors hidden behind Internal
+func StatusError(ctx context.Context, err error, resource string) error {
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	isPgErr := errors.As(err, &pgErr)
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, resource+" not found")
+	case errors.Is(err, model.ErrVersionConflict):
+		return status.Error(codes.Aborted, resource+" was changed concurrently")
+	case errors.Is(err, model.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, "invalid cursor")
+	case IsDuplicate(err):
+		return status.Error(codes.AlreadyExists, resource+" already exists")
+	case isPgErr && pgErr.Code == foreignKeyViolation:
+		return status.Error(codes.FailedPrecondition, resource+" refers to a record that does not exist")
+	case isPgErr && pgErr.Code == invalidTextRepresentation:
+		return status.Error(codes.InvalidArgument, "invalid "+resource+" id")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	}
+
+	log.Ctx(ctx).Error().
+		Err(err).
+		Str("service", "database").
+		Str("module", resource).
+		Msg("Unexpected database error")
+	return status.Error(codes.Internal, "internal error")
+}
+
+// IsDuplicate reports whether err is a unique constraint violation
+func IsDuplicate(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.Is(err, gorm.ErrDuplicatedKey) || errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
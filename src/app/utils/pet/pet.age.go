@@ -0,0 +1,188 @@
+package pet
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// birthdateLayout is the date only form of a birthdate, RFC 3339 timestamps are accepted too
+const birthdateLayout = "2006-01-02"
+
+// monthRangePattern matches an age range in months, "<min>-<max>", either bound may be left out
+var monthRangePattern = regexp.MustCompile(`^(\d*)-(\d*)$`)
+
+// AgeBand is a named age range of a pet type in months, without an upper bound when MaxMonths is 0
+type AgeBand struct {
+	Name      string
+	MinMonths int
+	MaxMonths int
+}
+
+// AgeBands are the age bands of every pet type, keyed by lowercase type
+type AgeBands map[string][]AgeBand
+
+// DefaultAgeBands are used when PET_AGE_BANDS is not set
+var DefaultAgeBands = AgeBands{
+	"cat": {{Name: "kitten", MinMonths: 0, MaxMonths: 12}, {Name: "adult", MinMonths: 12, MaxMonths: 84}, {Name: "senior", MinMonths: 84}},
+	"dog": {{Name: "puppy", MinMonths: 0, MaxMonths: 12}, {Name: "adult", MinMonths: 12, MaxMonths: 84}, {Name: "senior", MinMonths: 84}},
+}
+
+// ParseAgeBands parses bands in months such as "cat:kitten=0-12,adult=12-;dog:puppy=0-12", DefaultAgeBands for ""
+func ParseAgeBands(in string) (AgeBands, error) {
+	if strings.TrimSpace(in) == "" {
+		return DefaultAgeBands, nil
+	}
+
+	result := AgeBands{}
+	for _, typeBands := range strings.Split(in, ";") {
+		petType, bands, ok := strings.Cut(typeBands, ":")
+		petType = strings.ToLower(strings.TrimSpace(petType))
+		if !ok || petType == "" {
+			return nil, fmt.Errorf("invalid age bands %q, expected <type>:<bands>", typeBands)
+		}
+
+		for _, band := range strings.Split(bands, ",") {
+			name, months, ok := strings.Cut(band, "=")
+			name = strings.TrimSpace(name)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("invalid age band %q of %s, expected <name>=<min>-<max>", band, petType)
+			}
+
+			minMonths, maxMonths, ok := parseMonthRange(strings.TrimSpace(months))
+			if !ok {
+				return nil, fmt.Errorf("invalid months %q of age band %s of %s", months, name, petType)
+			}
+			result[petType] = append(result[petType], AgeBand{Name: name, MinMonths: minMonths, MaxMonths: maxMonths})
+		}
+	}
+
+	return result, nil
+}
+
+// Of returns the name of the age band of the pet type the birthdate falls in, or "" when there is none
+func (b AgeBands) Of(petType string, birthdate *time.Time, now time.Time) string {
+	if birthdate == nil {
+		return ""
+	}
+
+	for _, band := range b[strings.ToLower(petType)] {
+		from, to := monthsAgo(now, band.MaxMonths), monthsAgo(now, band.MinMonths)
+		if (band.MaxMonths == 0 || birthdate.After(from)) && !birthdate.After(to) {
+			return band.Name
+		}
+	}
+	return ""
+}
+
+// BirthdateRanges returns the birthdates of the age, a range in months "<min>-<max>" or the name of an age band
+func (b AgeBands) BirthdateRanges(age string, petType string, now time.Time) ([]pet.BirthdateRange, error) {
+	age = strings.TrimSpace(age)
+	if age == "" {
+		return nil, nil
+	}
+
+	if monthRangePattern.MatchString(age) {
+		minMonths, maxMonths, ok := parseMonthRange(age)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid age range %q", age)
+		}
+		return []pet.BirthdateRange{birthdateRange("", AgeBand{MinMonths: minMonths, MaxMonths: maxMonths}, now)}, nil
+	}
+
+	types := []string{strings.ToLower(petType)}
+	if petType == "" {
+		types = types[:0]
+		for t := range b {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+	}
+
+	var result []pet.BirthdateRange
+	for _, t := range types {
+		for _, band := range b[t] {
+			if strings.EqualFold(band.Name, age) {
+				result = append(result, birthdateRange(t, band, now))
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown age %q", age)
+	}
+
+	return result, nil
+}
+
+// ParseBirthdate parses a date, "2006-01-02", or an RFC 3339 timestamp of which only the date is kept
+func ParseBirthdate(in string) (*time.Time, error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(birthdateLayout, in)
+	if err != nil {
+		timestamp, rfcErr := time.Parse(time.RFC3339, in)
+		if rfcErr != nil {
+			return nil, err
+		}
+		date = time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return &date, nil
+}
+
+// FormatBirthdate formats a birthdate as an RFC 3339 timestamp at midnight UTC, the form clients always received
+func FormatBirthdate(in *time.Time) string {
+	if in == nil {
+		return ""
+	}
+	return in.Format(time.RFC3339)
+}
+
+func birthdateRange(petType string, band AgeBand, now time.Time) pet.BirthdateRange {
+	to := monthsAgo(now, band.MinMonths)
+	result := pet.BirthdateRange{Type: petType, To: &to}
+	if band.MaxMonths > 0 {
+		from := monthsAgo(now, band.MaxMonths)
+		result.From = &from
+	}
+	return result
+}
+
+// monthsAgo returns the date the given number of months before the date of now
+func monthsAgo(now time.Time, months int) time.Time {
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, -months, 0)
+}
+
+func parseMonthRange(in string) (minMonths int, maxMonths int, ok bool) {
+	match := monthRangePattern.FindStringSubmatch(in)
+	if match == nil || match[1] == "" && match[2] == "" {
+		return 0, 0, false
+	}
+
+	if match[1] != "" {
+		if minMonths, ok = parseMonths(match[1]); !ok {
+			return 0, 0, false
+		}
+	}
+	if match[2] != "" {
+		if maxMonths, ok = parseMonths(match[2]); !ok || maxMonths <= minMonths {
+			return 0, 0, false
+		}
+	}
+
+	return minMonths, maxMonths, true
+}
+
+func parseMonths(in string) (int, bool) {
+	months, err := strconv.Atoi(in)
+	return months, err == nil && months >= 0
+}
@@ -0,0 +1,51 @@
+package pet
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PageCursor decodes the cursor a listing continues from, or returns nil when there is none
+func PageCursor(token string) (*model.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	return DecodeCursor(token)
+}
+
+// NextCursor encodes the cursor of the page after a listing, or returns "" when there is no next page
+func NextCursor(next *model.Cursor) string {
+	if next.ID == "" {
+		return ""
+	}
+
+	return EncodeCursor(next)
+}
+
+func EncodeCursor(cursor *model.Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (*model.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	cursor := &model.Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.Sort == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	return cursor, nil
+}
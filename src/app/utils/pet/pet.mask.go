@@ -0,0 +1,100 @@
+package pet
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// UpdateMaskHeader is the metadata listing the comma separated Pet fields an update writes, e.g. "isVisible,adoptBy"
+const UpdateMaskHeader = "x-update-mask"
+
+// updatableColumns maps the updatable Pet fields to their columns
+var updatableColumns = map[string]string{
+	"type":         "type",
+	"name":         "name",
+	"birthdate":    "birthdate",
+	"gender":       "gender",
+	"color":        "color",
+	"pattern":      "pattern",
+	"habit":        "habit",
+	"caption":      "caption",
+	"status":       "status",
+	"isSterile":    "is_sterile",
+	"isVaccinated": "is_vaccinated",
+	"isVisible":    "is_visible",
+	"origin":       "origin",
+	"address":      "address",
+	"contact":      "contact",
+	"adoptBy":      "adopt_by",
+	"publishAt":    "publish_at",
+	"unpublishAt":  "unpublish_at",
+}
+
+// UpdateMask reads the field mask from the UpdateMaskHeader, without it every non-zero field of in is updated
+func UpdateMask(ctx context.Context, in *proto.Pet) (*fieldmaskpb.FieldMask, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(UpdateMaskHeader); len(values) > 0 {
+			return parseUpdateMask(values)
+		}
+	}
+
+	mask := &fieldmaskpb.FieldMask{}
+	if in == nil {
+		return mask, nil
+	}
+
+	value := reflect.ValueOf(in).Elem()
+	for field := range updatableColumns {
+		if !value.FieldByName(strings.ToUpper(field[:1]) + field[1:]).IsZero() {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	sort.Strings(mask.Paths)
+
+	return mask, nil
+}
+
+// MaskColumns returns the columns written by the fields of the mask
+func MaskColumns(mask *fieldmaskpb.FieldMask) []string {
+	columns := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		columns = append(columns, updatableColumns[path])
+	}
+	return columns
+}
+
+func parseUpdateMask(values []string) (*fieldmaskpb.FieldMask, error) {
+	var paths []string
+	for _, value := range values {
+		for _, path := range strings.Split(value, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range paths {
+		if _, ok := updatableColumns[path]; !ok {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       UpdateMaskHeader,
+				Description: "unknown or read-only field " + path,
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, invalidArgument(violations)
+	}
+
+	mask := &fieldmaskpb.FieldMask{Paths: paths}
+	mask.Normalize()
+
+	return mask, nil
+}
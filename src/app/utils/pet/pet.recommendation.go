@@ -0,0 +1,100 @@
+package pet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+)
+
+// weight of every attribute compared between pets, the type matters most, then the age
+var attributeWeights = map[string]float64{
+	"type":    3,
+	"age":     2,
+	"color":   1,
+	"pattern": 1,
+	"gender":  1,
+}
+
+// weight of the "users who liked X also liked Y" signal against the attribute similarity, when there is any
+const coLikeWeight = 0.6
+
+// Profile counts how often every attribute value appears among the pets a user likes
+type Profile struct {
+	counts   map[string]map[string]int
+	size     int
+	ageBands AgeBands
+	now      time.Time
+}
+
+func NewProfile(liked []*pet.Pet, ageBands AgeBands, now time.Time) *Profile {
+	profile := &Profile{counts: make(map[string]map[string]int), size: len(liked), ageBands: ageBands, now: now}
+	for _, p := range liked {
+		for attribute, value := range profile.attributes(p) {
+			if value == "" {
+				continue
+			}
+			if profile.counts[attribute] == nil {
+				profile.counts[attribute] = make(map[string]int)
+			}
+			profile.counts[attribute][value]++
+		}
+	}
+	return profile
+}
+
+// Similarity scores from 0 to 1 how much the pet looks like the liked pets
+func (p *Profile) Similarity(in *pet.Pet) float64 {
+	if p.size == 0 {
+		return 0
+	}
+
+	var score, total float64
+	for attribute, value := range p.attributes(in) {
+		weight := attributeWeights[attribute]
+		total += weight
+		score += weight * float64(p.counts[attribute][value]) / float64(p.size)
+	}
+	return score / total
+}
+
+// Recommend returns the best limit candidates by similarity to the profile blended with their co-likes
+func Recommend(profile *Profile, candidates []*pet.Pet, coLikes map[string]int64, limit int) []*pet.Pet {
+	var maxCoLikes int64
+	for _, count := range coLikes {
+		if count > maxCoLikes {
+			maxCoLikes = count
+		}
+	}
+
+	scores := make(map[*pet.Pet]float64, len(candidates))
+	for _, candidate := range candidates {
+		score := profile.Similarity(candidate)
+		if maxCoLikes > 0 {
+			coLiked := float64(coLikes[candidate.ID.String()]) / float64(maxCoLikes)
+			score = coLikeWeight*coLiked + (1-coLikeWeight)*score
+		}
+		scores[candidate] = score
+	}
+
+	result := make([]*pet.Pet, len(candidates))
+	copy(result, candidates)
+	sort.SliceStable(result, func(i, j int) bool {
+		return scores[result[i]] > scores[result[j]]
+	})
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+func (p *Profile) attributes(in *pet.Pet) map[string]string {
+	return map[string]string{
+		"type":    in.Type,
+		"age":     p.ageBands.Of(in.Type, in.Birthdate, p.now),
+		"color":   in.Color,
+		"pattern": in.Pattern,
+		"gender":  string(in.Gender),
+	}
+}
@@ -0,0 +1,46 @@
+package pet
+
+import (
+	"slices"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleColumns are the columns of the publishing schedule of a pet
+var ScheduleColumns = []string{"publish_at", "unpublish_at"}
+
+// ValidateSchedule checks the pet is unpublished after it is published, the times not in columns come from current
+func ValidateSchedule(current *pet.Pet, in *pet.Pet, columns []string) error {
+	publishAt, unpublishAt := current.PublishAt, current.UnpublishAt
+	if slices.Contains(columns, "publish_at") {
+		publishAt = in.PublishAt
+	}
+	if slices.Contains(columns, "unpublish_at") {
+		unpublishAt = in.UnpublishAt
+	}
+
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return status.Error(codes.InvalidArgument, "unpublish time must be after the publish time")
+	}
+
+	return nil
+}
+
+func toTime(in *timestamppb.Timestamp) *time.Time {
+	if in == nil {
+		return nil
+	}
+	t := in.AsTime()
+	return &t
+}
+
+func toTimestamp(in *time.Time) *timestamppb.Timestamp {
+	if in == nil {
+		return nil
+	}
+	return timestamppb.New(*in)
+}
@@ -0,0 +1,23 @@
+package pet
+
+import (
+	"slices"
+	"strings"
+
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SortOrder parses the order of a pet listing, empty is relevance for a search and petConst.NEWEST otherwise
+func SortOrder(in string) (petConst.Sort, error) {
+	sort := petConst.Sort(strings.ToLower(strings.TrimSpace(in)))
+	if sort == "" {
+		return "", nil
+	}
+	if !slices.Contains(petConst.Sorts, sort) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid sort %q", in)
+	}
+
+	return sort, nil
+}
@@ -1,7 +1,6 @@
 package pet
 
 import (
-	"errors"
 	"math"
 	"strings"
 	"time"
@@ -9,87 +8,66 @@ import (
 	"github.com/google/uuid"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
-	"github.com/isd-sgcu/johnjud-backend/src/constant"
 	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
-	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/pet/v1"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
 	imageProto "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
 	"gorm.io/gorm"
 )
 
-func FilterPet(in *[]*pet.Pet, query *proto.FindAllPetRequest) error {
-	var results []*pet.Pet
-	for _, p := range *in {
-		res, err := filterAge(p, query.Age)
-		if err != nil {
-			return err
-		}
-		if !res {
-			continue
-		}
-		if query.Search != "" && !strings.Contains(p.Name, query.Search) {
-			continue
-		}
-		if query.Type != "" && p.Type != query.Type {
-			continue
-		}
-		if query.Gender != "" && p.Gender != petConst.Gender(query.Gender) {
-			continue
-		}
-		if query.Color != "" && p.Color != query.Color {
-			continue
-		}
-		if query.Pattern != "" && p.Pattern != query.Pattern {
-			continue
-		}
-		if query.Origin != "" && p.Origin != query.Origin {
-			continue
-		}
-		results = append(results, p)
+func FindAllQuery(in *proto.FindAllPetRequest, ageBands AgeBands) (*pet.FindAllQuery, error) {
+	birthdates, err := ageBands.BirthdateRanges(in.Age, in.Type, time.Now())
+	if err != nil {
+		return nil, err
 	}
-	*in = results
-	return nil
+	sort, err := SortOrder(in.Sort)
+	if err != nil {
+		return nil, err
+	}
+	after, err := PageCursor(in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pet.FindAllQuery{
+		Search:     strings.TrimSpace(in.Search),
+		Type:       in.Type,
+		Gender:     petConst.Gender(in.Gender),
+		Color:      in.Color,
+		Pattern:    in.Pattern,
+		Origin:     in.Origin,
+		Birthdates: birthdates,
+		Sort:       sort,
+		After:      after,
+		Page:       in.Page,
+		PageSize:   in.PageSize,
+	}, nil
 }
 
-func PaginatePets(pets *[]*pet.Pet, page int32, pageSize int32, metadata *proto.FindAllPetMetaData) error {
-	totalsPets := int32(len(*pets))
+func PaginationMetaData(total int64, page int32, pageSize int32, metadata *proto.FindAllPetMetaData) {
 	if page <= 0 {
 		page = 1
 	}
 	if pageSize <= 0 {
-		pageSize = totalsPets
+		pageSize = int32(total)
 	}
-	start := (page - 1) * pageSize
-	end := start + pageSize
 
-	if start > totalsPets {
-		*pets = []*pet.Pet{}
-		return nil
-	}
-	if end > totalsPets {
-		end = totalsPets
+	var totalPages int32
+	if pageSize > 0 {
+		totalPages = int32(math.Ceil(float64(total) / float64(pageSize)))
 	}
-	*pets = (*pets)[start:end]
-
-	totalPages := int32(math.Ceil(float64(totalsPets) / float64(pageSize)))
 
 	metadata.Page = page
 	metadata.PageSize = pageSize
-	metadata.Total = totalsPets
+	metadata.Total = int32(total)
 	metadata.TotalPages = totalPages
-	return nil
 }
 
-func RawToDtoList(in *[]*pet.Pet, images [][]*imageProto.Image, query *proto.FindAllPetRequest) ([]*proto.Pet, error) {
+func RawToDtoList(in *[]*pet.Pet, images map[string][]*imageProto.Image) []*proto.Pet {
 	var result []*proto.Pet
-	if len(*in) != len(images) {
-		return nil, errors.New("length of in and imageUrls have to be the same")
-	}
-
-	for i, p := range *in {
-		// TODO: create new filter image function this wont work
-		result = append(result, RawToDto(p, images[i]))
+	for _, p := range *in {
+		result = append(result, RawToDto(p, images[p.ID.String()]))
 	}
-	return result, nil
+	return result
 }
 
 func RawToDto(in *pet.Pet, images []*imageProto.Image) *proto.Pet {
@@ -97,7 +75,7 @@ func RawToDto(in *pet.Pet, images []*imageProto.Image) *proto.Pet {
 		Id:           in.ID.String(),
 		Type:         in.Type,
 		Name:         in.Name,
-		Birthdate:    in.Birthdate,
+		Birthdate:    FormatBirthdate(in.Birthdate),
 		Gender:       string(in.Gender),
 		Color:        in.Color,
 		Pattern:      in.Pattern,
@@ -112,6 +90,9 @@ func RawToDto(in *pet.Pet, images []*imageProto.Image) *proto.Pet {
 		Address:      in.Address,
 		Contact:      in.Contact,
 		AdoptBy:      in.AdoptBy,
+		Version:      in.Version,
+		PublishAt:    toTimestamp(in.PublishAt),
+		UnpublishAt:  toTimestamp(in.UnpublishAt),
 	}
 }
 
@@ -127,6 +108,11 @@ func DtoToRaw(in *proto.Pet) (res *pet.Pet, err error) {
 		}
 	}
 
+	birthdate, err := ParseBirthdate(in.Birthdate)
+	if err != nil {
+		return nil, err
+	}
+
 	switch in.Gender {
 	case string(petConst.MALE):
 		gender = petConst.MALE
@@ -150,7 +136,7 @@ func DtoToRaw(in *proto.Pet) (res *pet.Pet, err error) {
 		},
 		Type:         in.Type,
 		Name:         in.Name,
-		Birthdate:    in.Birthdate,
+		Birthdate:    birthdate,
 		Gender:       gender,
 		Color:        in.Color,
 		Pattern:      in.Pattern,
@@ -164,6 +150,8 @@ func DtoToRaw(in *proto.Pet) (res *pet.Pet, err error) {
 		Address:      in.Address,
 		Contact:      in.Contact,
 		AdoptBy:      in.AdoptBy,
+		PublishAt:    toTime(in.PublishAt),
+		UnpublishAt:  toTime(in.UnpublishAt),
 	}, nil
 }
 
@@ -174,33 +162,3 @@ func ExtractImageUrls(in []*imageProto.Image) []string {
 	}
 	return result
 }
-
-func parseDate(dateStr string) (time.Time, error) {
-	parsedTime, err := time.Parse(time.RFC3339, dateStr)
-	if err != nil {
-		return time.Time{}, err
-	}
-	return parsedTime, nil
-}
-
-func filterAge(pet *pet.Pet, age string) (bool, error) {
-	birthdate, err := parseDate(pet.Birthdate)
-	if err != nil {
-		return false, err
-	}
-
-	currYear := time.Now()
-	birthYear := birthdate
-	diff := currYear.Sub(birthYear).Hours() / constant.DAY / constant.YEAR
-
-	switch age {
-	case "kitten":
-		return diff < 1, nil
-	case "adult":
-		return diff >= 1 && diff < 7, nil
-	case "senior":
-		return diff >= 7, nil
-	default:
-		return true, nil
-	}
-}
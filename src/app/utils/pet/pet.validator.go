@@ -0,0 +1,127 @@
+package pet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	petConst "github.com/isd-sgcu/johnjud-backend/src/constant/pet"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// StatusOverrideHeader is the metadata an admin sets to "true" to force a status transition that is not allowed
+const StatusOverrideHeader = "x-status-override"
+
+// ValidateCreate requires every enum field to be set to a known value
+func ValidateCreate(in *proto.Pet) error {
+	return validate(in, func(string) bool { return true })
+}
+
+// ValidateUpdate only requires the fields listed in the mask, as the other fields are not updated
+func ValidateUpdate(in *proto.Pet, mask *fieldmaskpb.FieldMask) error {
+	return validate(in, func(field string) bool { return contains(mask.GetPaths(), field) })
+}
+
+func ValidateStatusTransition(ctx context.Context, from petConst.Status, to petConst.Status) error {
+	if to == "" || from == to {
+		return nil
+	}
+
+	for _, s := range petConst.StatusTransitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+
+	if authUtils.IsAdmin(ctx) && hasStatusOverride(ctx) {
+		return nil
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "cannot change status from %v to %v", from, to)
+}
+
+func validate(in *proto.Pet, required func(field string) bool) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field string, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+	}
+
+	if in == nil {
+		violate("pet", "is required")
+		return invalidArgument(violations)
+	}
+
+	if strings.TrimSpace(in.Name) == "" && required("name") {
+		violate("name", "is required")
+	}
+
+	if in.Gender == "" && required("gender") {
+		violate("gender", "is required")
+	} else if in.Gender != "" && !contains(petConst.Genders, petConst.Gender(in.Gender)) {
+		violate("gender", fmt.Sprintf("must be one of %v", petConst.Genders))
+	}
+
+	if in.Status == "" && required("status") {
+		violate("status", "is required")
+	} else if in.Status != "" && !contains(petConst.Statuses, petConst.Status(in.Status)) {
+		violate("status", fmt.Sprintf("must be one of %v", petConst.Statuses))
+	}
+
+	if birthdate, err := ParseBirthdate(in.Birthdate); err != nil {
+		violate("birthdate", "must be a date, YYYY-MM-DD, or an RFC 3339 timestamp")
+	} else if birthdate != nil && birthdate.After(time.Now()) {
+		violate("birthdate", "must not be in the future")
+	}
+
+	if in.PublishAt != nil && in.PublishAt.CheckValid() != nil {
+		violate("publishAt", "must be a valid timestamp")
+	}
+	if in.UnpublishAt != nil && in.UnpublishAt.CheckValid() != nil {
+		violate("unpublishAt", "must be a valid timestamp")
+	}
+
+	if len(violations) > 0 {
+		return invalidArgument(violations)
+	}
+	return nil
+}
+
+func invalidArgument(violations []*errdetails.BadRequest_FieldViolation) error {
+	var fields []string
+	for _, v := range violations {
+		fields = append(fields, v.Field+" "+v.Description)
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid pet: "+strings.Join(fields, ", "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func hasStatusOverride(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(StatusOverrideHeader)
+	return len(values) > 0 && values[0] == "true"
+}
+
+func contains[T comparable](list []T, value T) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package pet
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// IncludeHiddenHeader is the metadata an admin sets to "true" to see hidden pets
+const IncludeHiddenHeader = "x-include-hidden"
+
+// IncludeHidden reports whether the caller asked to see hidden pets, asking without being an admin is denied
+func IncludeHidden(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+
+	values := md.Get(IncludeHiddenHeader)
+	if len(values) == 0 {
+		return false, nil
+	}
+
+	include, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid %s", IncludeHiddenHeader)
+	}
+	if include && !authUtils.IsAdmin(ctx) {
+		return false, status.Error(codes.PermissionDenied, "only admins can see hidden pets")
+	}
+
+	return include, nil
+}
+
+// CanInteract reports whether the caller can act on the pet, hidden pets only exist for admins
+func CanInteract(ctx context.Context, in *pet.Pet) bool {
+	return in.IsVisible || authUtils.IsAdmin(ctx)
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/user/v1"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func ComparePassword(hash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// RawToDto never exposes the password hash
+func RawToDto(in *user.User) *proto.User {
+	return &proto.User{
+		Id:        in.ID.String(),
+		Email:     in.Email,
+		Firstname: in.Firstname,
+		Lastname:  in.Lastname,
+		Role:      string(in.Role),
+	}
+}
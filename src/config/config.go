@@ -5,7 +5,8 @@ import (
 )
 
 type Database struct {
-	Url string `mapstructure:"URL"`
+	Url            string `mapstructure:"URL"`
+	MigrateOnStart bool   `mapstructure:"MIGRATE_ON_START"`
 }
 
 type App struct {
@@ -17,10 +18,72 @@ type Service struct {
 	File string `mapstructure:"FILE"`
 }
 
+type Client struct {
+	Timeout          int `mapstructure:"TIMEOUT"`       // per-call deadline in milliseconds
+	MaxRetries       int `mapstructure:"MAX_RETRIES"`   // retries of a call failing with a transient error
+	RetryBackoff     int `mapstructure:"RETRY_BACKOFF"` // initial retry backoff in milliseconds
+	BreakerThreshold int `mapstructure:"BREAKER_THRESHOLD"`
+	BreakerCooldown  int `mapstructure:"BREAKER_COOLDOWN"` // in seconds
+	CacheSize        int `mapstructure:"CACHE_SIZE"`       // number of pets whose images are kept
+	CacheTTL         int `mapstructure:"CACHE_TTL"`        // in seconds
+}
+
+type Jwt struct {
+	Secret     string `mapstructure:"SECRET"`
+	Issuer     string `mapstructure:"ISSUER"`
+	AccessTTL  int    `mapstructure:"ACCESS_TTL"`  // in seconds
+	RefreshTTL int    `mapstructure:"REFRESH_TTL"` // in seconds
+}
+
+type Purge struct {
+	Retention int `mapstructure:"RETENTION"` // days a deleted pet is kept before being purged
+	Interval  int `mapstructure:"INTERVAL"`  // minutes between purges, 0 disables purging
+}
+
+type Schedule struct {
+	Interval int `mapstructure:"INTERVAL"` // seconds between applying the publishing schedule, 0 disables it
+}
+
+type Health struct {
+	Interval int `mapstructure:"INTERVAL"` // seconds between health checks
+	Timeout  int `mapstructure:"TIMEOUT"`  // per-check deadline in milliseconds
+	Drain    int `mapstructure:"DRAIN"`    // seconds services report NOT_SERVING before the server stops on shutdown
+}
+
+type Metrics struct {
+	Port int `mapstructure:"PORT"` // port of the HTTP server exposing /metrics, 0 disables it
+}
+
+type Tracing struct {
+	Exporter    string  `mapstructure:"EXPORTER"`     // "otlp", "stdout" or "none"
+	Endpoint    string  `mapstructure:"ENDPOINT"`     // host:port of the OTLP collector
+	Insecure    bool    `mapstructure:"INSECURE"`     // send to the OTLP collector without TLS
+	SampleRatio float64 `mapstructure:"SAMPLE_RATIO"` // fraction of the traces started here that are sampled, 1 when unset
+}
+
+type Log struct {
+	SampleEvery int    `mapstructure:"SAMPLE_EVERY"` // log one of every n successful requests, failed ones are always logged
+	Requests    bool   `mapstructure:"REQUESTS"`     // log the requests along with their outcome
+	Redact      string `mapstructure:"REDACT"`       // comma separated request fields never logged as is, built-in fields when empty
+}
+
+type Pet struct {
+	AgeBands string `mapstructure:"AGE_BANDS"` // "<type>:<name>=<min months>-<max months>,...;<type>:...", built-in bands when empty
+}
+
 type Config struct {
 	App      App
 	Database Database
 	Service  Service
+	Client   Client
+	Jwt      Jwt
+	Purge    Purge
+	Schedule Schedule
+	Health   Health
+	Metrics  Metrics
+	Tracing  Tracing
+	Log      Log
+	Pet      Pet
 }
 
 func LoadConfig() (*Config, error) {
@@ -51,10 +114,100 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	clientCfgLdr := viper.New()
+	clientCfgLdr.SetEnvPrefix("CLIENT")
+	clientCfgLdr.AutomaticEnv()
+	clientCfgLdr.AllowEmptyEnv(false)
+	clientConfig := Client{}
+	if err := clientCfgLdr.Unmarshal(&clientConfig); err != nil {
+		return nil, err
+	}
+
+	jwtCfgLdr := viper.New()
+	jwtCfgLdr.SetEnvPrefix("JWT")
+	jwtCfgLdr.AutomaticEnv()
+	jwtCfgLdr.AllowEmptyEnv(false)
+	jwtConfig := Jwt{}
+	if err := jwtCfgLdr.Unmarshal(&jwtConfig); err != nil {
+		return nil, err
+	}
+
+	purgeCfgLdr := viper.New()
+	purgeCfgLdr.SetEnvPrefix("PURGE")
+	purgeCfgLdr.AutomaticEnv()
+	purgeCfgLdr.AllowEmptyEnv(false)
+	purgeConfig := Purge{}
+	if err := purgeCfgLdr.Unmarshal(&purgeConfig); err != nil {
+		return nil, err
+	}
+
+	scheduleCfgLdr := viper.New()
+	scheduleCfgLdr.SetEnvPrefix("SCHEDULE")
+	scheduleCfgLdr.AutomaticEnv()
+	scheduleCfgLdr.AllowEmptyEnv(false)
+	scheduleConfig := Schedule{}
+	if err := scheduleCfgLdr.Unmarshal(&scheduleConfig); err != nil {
+		return nil, err
+	}
+
+	healthCfgLdr := viper.New()
+	healthCfgLdr.SetEnvPrefix("HEALTH")
+	healthCfgLdr.AutomaticEnv()
+	healthCfgLdr.AllowEmptyEnv(false)
+	healthConfig := Health{}
+	if err := healthCfgLdr.Unmarshal(&healthConfig); err != nil {
+		return nil, err
+	}
+
+	metricsCfgLdr := viper.New()
+	metricsCfgLdr.SetEnvPrefix("METRICS")
+	metricsCfgLdr.AutomaticEnv()
+	metricsCfgLdr.AllowEmptyEnv(false)
+	metricsConfig := Metrics{}
+	if err := metricsCfgLdr.Unmarshal(&metricsConfig); err != nil {
+		return nil, err
+	}
+
+	tracingCfgLdr := viper.New()
+	tracingCfgLdr.SetEnvPrefix("TRACING")
+	tracingCfgLdr.AutomaticEnv()
+	tracingCfgLdr.AllowEmptyEnv(false)
+	tracingConfig := Tracing{}
+	if err := tracingCfgLdr.Unmarshal(&tracingConfig); err != nil {
+		return nil, err
+	}
+
+	logCfgLdr := viper.New()
+	logCfgLdr.SetEnvPrefix("LOG")
+	logCfgLdr.AutomaticEnv()
+	logCfgLdr.AllowEmptyEnv(false)
+	logConfig := Log{}
+	if err := logCfgLdr.Unmarshal(&logConfig); err != nil {
+		return nil, err
+	}
+
+	petCfgLdr := viper.New()
+	petCfgLdr.SetEnvPrefix("PET")
+	petCfgLdr.AutomaticEnv()
+	petCfgLdr.AllowEmptyEnv(false)
+	petConfig := Pet{}
+	if err := petCfgLdr.Unmarshal(&petConfig); err != nil {
+		return nil, err
+	}
+
 	config := &Config{
 		Database: dbConfig,
 		App:      appConfig,
 		Service:  serviceConfig,
+		Client:   clientConfig,
+		Jwt:      jwtConfig,
+		Purge:    purgeConfig,
+		Schedule: scheduleConfig,
+		Health:   healthConfig,
+		Metrics:  metricsConfig,
+		Tracing:  tracingConfig,
+		Log:      logConfig,
+		Pet:      petConfig,
 	}
 
 	return config, nil
@@ -0,0 +1,21 @@
+package adoption
+
+type Status string
+
+const (
+	SUBMITTED    Status = "submitted"
+	UNDER_REVIEW Status = "under_review"
+	APPROVED     Status = "approved"
+	REJECTED     Status = "rejected"
+	COMPLETED    Status = "completed"
+)
+
+// Transitions lists the statuses an application in a given status can move to
+var Transitions = map[Status][]Status{
+	SUBMITTED:    {UNDER_REVIEW, REJECTED},
+	UNDER_REVIEW: {APPROVED, REJECTED},
+	APPROVED:     {COMPLETED, REJECTED},
+}
+
+// OPEN statuses still block the applicant from applying again for the same pet
+var OPEN = []Status{SUBMITTED, UNDER_REVIEW, APPROVED}
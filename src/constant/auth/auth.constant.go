@@ -0,0 +1,8 @@
+package auth
+
+type TokenType string
+
+const (
+	ACCESS_TOKEN  TokenType = "access"
+	REFRESH_TOKEN TokenType = "refresh"
+)
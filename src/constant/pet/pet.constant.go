@@ -13,3 +13,26 @@ const (
 	ADOPTED  Status = "adopted"
 	FINDHOME Status = "findhome"
 )
+
+var Genders = []Gender{MALE, FEMALE}
+
+var Statuses = []Status{ADOPTED, FINDHOME}
+
+// StatusTransitions lists the statuses a pet in a given status can move to without an admin override
+var StatusTransitions = map[Status][]Status{
+	FINDHOME: {ADOPTED},
+}
+
+type Sort string
+
+const (
+	NEWEST     Sort = "newest"
+	OLDEST     Sort = "oldest"
+	YOUNGEST   Sort = "youngest"
+	ELDEST     Sort = "eldest"
+	NAME       Sort = "name"
+	MOST_LIKED Sort = "most_liked"
+	RELEVANCE  Sort = "relevance" // only orders a search, other listings are ordered by NEWEST
+)
+
+var Sorts = []Sort{NEWEST, OLDEST, YOUNGEST, ELDEST, NAME, MOST_LIKED, RELEVANCE}
@@ -0,0 +1,8 @@
+package user
+
+type Role string
+
+const (
+	USER  Role = "user"
+	ADMIN Role = "admin"
+)
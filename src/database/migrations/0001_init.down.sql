@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS likes;
+DROP TABLE IF EXISTS pets;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,61 @@
+-- Matches the schema previously created by gorm AutoMigrate, so it is safe to run against an existing database.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         uuid PRIMARY KEY,
+    created_at timestamp,
+    updated_at timestamp,
+    deleted_at timestamp,
+    email      text,
+    password   text,
+    firstname  text,
+    lastname   text,
+    role       text
+);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+
+CREATE TABLE IF NOT EXISTS pets (
+    id            uuid PRIMARY KEY,
+    created_at    timestamp,
+    updated_at    timestamp,
+    deleted_at    timestamp,
+    type          text,
+    name          text,
+    birthdate     text,
+    gender        text,
+    color         text,
+    pattern       text,
+    habit         text,
+    caption       text,
+    status        text,
+    is_sterile    boolean,
+    is_vaccinated boolean,
+    is_visible    boolean,
+    origin        text,
+    address       text,
+    contact       text,
+    adopt_by      text
+);
+CREATE INDEX IF NOT EXISTS idx_pets_deleted_at ON pets (deleted_at);
+
+CREATE TABLE IF NOT EXISTS likes (
+    id         uuid PRIMARY KEY,
+    created_at timestamp,
+    updated_at timestamp,
+    deleted_at timestamp,
+    pet_id     uuid,
+    user_id    uuid
+);
+CREATE INDEX IF NOT EXISTS idx_likes_deleted_at ON likes (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_name ON likes (pet_id, user_id);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_likes_pet') THEN
+        ALTER TABLE likes ADD CONSTRAINT fk_likes_pet FOREIGN KEY (pet_id) REFERENCES pets (id) ON UPDATE CASCADE;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_likes_user') THEN
+        ALTER TABLE likes ADD CONSTRAINT fk_likes_user FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE;
+    END IF;
+END
+$$;
@@ -0,0 +1,19 @@
+CREATE TABLE adoptions (
+    id          uuid PRIMARY KEY,
+    created_at  timestamp,
+    updated_at  timestamp,
+    deleted_at  timestamp,
+    pet_id      uuid NOT NULL REFERENCES pets (id) ON UPDATE CASCADE,
+    user_id     uuid NOT NULL REFERENCES users (id) ON UPDATE CASCADE,
+    status      text NOT NULL,
+    message     text,
+    reviewed_by uuid REFERENCES users (id) ON UPDATE CASCADE,
+    review_note text
+);
+CREATE INDEX idx_adoptions_deleted_at ON adoptions (deleted_at);
+CREATE INDEX idx_adoptions_pet_id ON adoptions (pet_id);
+CREATE INDEX idx_adoptions_user_id ON adoptions (user_id);
+
+-- a pet can only have one approved (or completed) application
+CREATE UNIQUE INDEX idx_adoptions_approved_pet_id ON adoptions (pet_id)
+    WHERE status IN ('approved', 'completed') AND deleted_at IS NULL;
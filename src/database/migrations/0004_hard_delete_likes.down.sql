@@ -0,0 +1,2 @@
+-- the deleted likes cannot be brought back
+SELECT 1;
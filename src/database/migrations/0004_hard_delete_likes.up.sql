@@ -0,0 +1,2 @@
+-- likes are now deleted permanently, soft-deleted ones would block liking the pet again through idx_name
+DELETE FROM likes WHERE deleted_at IS NOT NULL;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_likes_created_at ON likes (created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_pets_habit_trgm;
+DROP INDEX IF EXISTS idx_pets_caption_trgm;
+DROP INDEX IF EXISTS idx_pets_name_trgm;
+DROP INDEX IF EXISTS idx_pets_search_vector;
+ALTER TABLE pets DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,15 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- the simple configuration neither stems nor drops stop words, so Thai words separated by spaces are kept as is
+ALTER TABLE pets ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(caption, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(habit, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_pets_search_vector ON pets USING gin (search_vector);
+
+-- Thai is written without spaces between words, trigram indexes serve the substring matches that find it
+CREATE INDEX IF NOT EXISTS idx_pets_name_trgm ON pets USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_pets_caption_trgm ON pets USING gin (caption gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_pets_habit_trgm ON pets USING gin (habit gin_trgm_ops);
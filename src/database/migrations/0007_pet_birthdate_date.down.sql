@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_pets_birthdate;
+ALTER TABLE pets ADD COLUMN birthdate_text text;
+UPDATE pets SET birthdate_text = to_char(birthdate, 'YYYY-MM-DD"T"00:00:00"Z"') WHERE birthdate IS NOT NULL;
+UPDATE pets SET birthdate_text = invalid_pet_birthdates.birthdate
+FROM invalid_pet_birthdates WHERE invalid_pet_birthdates.pet_id = pets.id AND pets.birthdate IS NULL;
+ALTER TABLE pets DROP COLUMN birthdate;
+ALTER TABLE pets RENAME COLUMN birthdate_text TO birthdate;
+DROP TABLE IF EXISTS invalid_pet_birthdates;
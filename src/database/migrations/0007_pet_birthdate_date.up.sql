@@ -0,0 +1,40 @@
+-- birthdates were RFC 3339 text, the calendar date they start with is kept as a real date. Values that are not a
+-- valid date are cleared and reported in invalid_pet_birthdates so they can be fixed by hand.
+CREATE TABLE IF NOT EXISTS invalid_pet_birthdates (
+    pet_id    uuid PRIMARY KEY,
+    birthdate text NOT NULL,
+    found_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE FUNCTION pg_temp.parse_birthdate(value text) RETURNS date AS $$
+BEGIN
+    IF value !~ '^[0-9]{4}-[0-9]{2}-[0-9]{2}' THEN
+        RETURN NULL;
+    END IF;
+    RETURN substring(value FOR 10)::date;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE pets ADD COLUMN birthdate_date date;
+UPDATE pets SET birthdate_date = pg_temp.parse_birthdate(birthdate);
+
+INSERT INTO invalid_pet_birthdates (pet_id, birthdate)
+SELECT id, birthdate FROM pets WHERE birthdate_date IS NULL AND coalesce(birthdate, '') <> ''
+ON CONFLICT (pet_id) DO UPDATE SET birthdate = excluded.birthdate, found_at = now();
+
+DO $$
+DECLARE
+    invalid bigint;
+BEGIN
+    SELECT count(*) INTO invalid FROM invalid_pet_birthdates;
+    IF invalid > 0 THEN
+        RAISE WARNING '% pet birthdates could not be converted, see invalid_pet_birthdates', invalid;
+    END IF;
+END
+$$;
+
+ALTER TABLE pets DROP COLUMN birthdate;
+ALTER TABLE pets RENAME COLUMN birthdate_date TO birthdate;
+CREATE INDEX IF NOT EXISTS idx_pets_birthdate ON pets (birthdate);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pets_unpublish_at;
+DROP INDEX IF EXISTS idx_pets_publish_at;
+ALTER TABLE pets DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE pets DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,5 @@
+ALTER TABLE pets ADD COLUMN IF NOT EXISTS publish_at timestamptz;
+ALTER TABLE pets ADD COLUMN IF NOT EXISTS unpublish_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_pets_publish_at ON pets (publish_at) WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_pets_unpublish_at ON pets (unpublish_at) WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
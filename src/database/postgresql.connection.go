@@ -1,9 +1,6 @@
 package database
 
 import (
-	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
-	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
-	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
 	"github.com/isd-sgcu/johnjud-backend/src/config"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -22,10 +19,5 @@ func InitPostgresDatabase(conf *config.Database, isDebug bool) (db *gorm.DB, err
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&user.User{}, &like.Like{}, &pet.Pet{})
-	if err != nil {
-		return nil, err
-	}
-
 	return
 }
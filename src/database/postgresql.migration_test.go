@@ -0,0 +1,81 @@
+package database
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrationTest struct {
+	suite.Suite
+}
+
+func TestMigration(t *testing.T) {
+	suite.Run(t, new(MigrationTest))
+}
+
+func (t *MigrationTest) TestLoadMigrationsSuccess() {
+	fsys := fstest.MapFS{
+		"migrations/0002_add_column.up.sql":   {Data: []byte("ALTER TABLE pets ADD COLUMN a text;")},
+		"migrations/0002_add_column.down.sql": {Data: []byte("ALTER TABLE pets DROP COLUMN a;")},
+		"migrations/0001_init.up.sql":         {Data: []byte("CREATE TABLE pets ();")},
+		"migrations/0001_init.down.sql":       {Data: []byte("DROP TABLE pets;")},
+	}
+	want := []*Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE pets ();", Down: "DROP TABLE pets;"},
+		{Version: 2, Name: "add_column", Up: "ALTER TABLE pets ADD COLUMN a text;", Down: "ALTER TABLE pets DROP COLUMN a;"},
+	}
+
+	actual, err := loadMigrations(fsys, "migrations")
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), want, actual)
+}
+
+func (t *MigrationTest) TestLoadMigrationsMissingDown() {
+	fsys := fstest.MapFS{
+		"migrations/0001_init.up.sql": {Data: []byte("CREATE TABLE pets ();")},
+	}
+
+	actual, err := loadMigrations(fsys, "migrations")
+
+	assert.Nil(t.T(), actual)
+	assert.Error(t.T(), err)
+}
+
+func (t *MigrationTest) TestLoadMigrationsDuplicateVersion() {
+	fsys := fstest.MapFS{
+		"migrations/0001_init.up.sql":    {Data: []byte("CREATE TABLE pets ();")},
+		"migrations/0001_init.down.sql":  {Data: []byte("DROP TABLE pets;")},
+		"migrations/0001_other.up.sql":   {Data: []byte("CREATE TABLE likes ();")},
+		"migrations/0001_other.down.sql": {Data: []byte("DROP TABLE likes;")},
+	}
+
+	actual, err := loadMigrations(fsys, "migrations")
+
+	assert.Nil(t.T(), actual)
+	assert.Error(t.T(), err)
+}
+
+func (t *MigrationTest) TestLoadMigrationsInvalidName() {
+	fsys := fstest.MapFS{
+		"migrations/init.sql": {Data: []byte("CREATE TABLE pets ();")},
+	}
+
+	actual, err := loadMigrations(fsys, "migrations")
+
+	assert.Nil(t.T(), actual)
+	assert.Error(t.T(), err)
+}
+
+func (t *MigrationTest) TestEmbeddedMigrations() {
+	migrations, err := loadMigrations(migrationFiles, "migrations")
+
+	assert.Nil(t.T(), err)
+	assert.NotEmpty(t.T(), migrations)
+	for i, m := range migrations {
+		assert.Equal(t.T(), int64(i+1), m.Version)
+	}
+}
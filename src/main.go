@@ -2,25 +2,47 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
 
+	imageClt "github.com/isd-sgcu/johnjud-backend/src/app/client/image"
+	healthMonitor "github.com/isd-sgcu/johnjud-backend/src/app/health"
+	"github.com/isd-sgcu/johnjud-backend/src/app/interceptor"
+	"github.com/isd-sgcu/johnjud-backend/src/app/metrics"
+	adoptionRepo "github.com/isd-sgcu/johnjud-backend/src/app/repository/adoption"
 	likeRepo "github.com/isd-sgcu/johnjud-backend/src/app/repository/like"
 	petRepo "github.com/isd-sgcu/johnjud-backend/src/app/repository/pet"
+	userRepo "github.com/isd-sgcu/johnjud-backend/src/app/repository/user"
+	adoptionSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/adoption"
+	authSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/auth"
 	imageSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/image"
 	likeSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/like"
 	petSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/pet"
+	tokenSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/token"
+	userSrv "github.com/isd-sgcu/johnjud-backend/src/app/service/user"
+	"github.com/isd-sgcu/johnjud-backend/src/app/tracing"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	petUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/pet"
 	"github.com/isd-sgcu/johnjud-backend/src/config"
 	"github.com/isd-sgcu/johnjud-backend/src/database"
-	likePb "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/like/v1"
-	petPb "github.com/isd-sgcu/johnjud-go-proto/johnjud/backend/pet/v1"
+	adoptionPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	likePb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/like/v1"
+	petPb "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/pet/v1"
+	authPb "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+	userPb "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/user/v1"
 	imagePb "github.com/isd-sgcu/johnjud-go-proto/johnjud/file/image/v1"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
@@ -84,7 +106,142 @@ func gracefulShutdown(ctx context.Context, timeout time.Duration, ops map[string
 	return wait
 }
 
+// migrate runs the "migrate up", "migrate down [steps]" and "migrate status" subcommands
+func migrate(migrator *database.Migrator, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [steps]|status")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			log.Info().
+				Str("service", "migration").
+				Msgf("applied %d_%s", m.Version, m.Name)
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid steps: %v", args[1])
+			}
+			steps = n
+		}
+
+		reverted, err := migrator.Down(steps)
+		for _, m := range reverted {
+			log.Info().
+				Str("service", "migration").
+				Msgf("reverted %d_%s", m.Version, m.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for _, st := range statuses {
+			appliedAt := "pending"
+			if st.AppliedAt != nil {
+				appliedAt = st.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", st.Version, st.Name, appliedAt)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command: %v", args[0])
+	}
+}
+
+// checkSchema refuses to boot on pending migrations unless they are allowed to be applied on start
+func checkSchema(migrator *database.Migrator, migrateOnStart bool) error {
+	if migrateOnStart {
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			log.Info().
+				Str("service", "migration").
+				Msgf("applied %d_%s", m.Version, m.Name)
+		}
+		return err
+	}
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d pending migrations, run \"migrate up\" first", len(pending))
+	}
+
+	return nil
+}
+
+// purgeDeletedPets periodically purges the pets deleted longer than the retention until ctx is done
+func purgeDeletedPets(ctx context.Context, petService *petSrv.Service, conf *config.Purge) {
+	ctx = authUtils.WithSystemIdentity(ctx)
+	retention := time.Duration(conf.Retention) * 24 * time.Hour
+
+	ticker := time.NewTicker(time.Duration(conf.Interval) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := petService.Purge(ctx, retention)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("service", "purge").
+					Msg("Failed to purge deleted pets")
+				continue
+			}
+
+			log.Info().
+				Str("service", "purge").
+				Msgf("purged %d deleted pets", purged)
+		}
+	}
+}
+
+// applySchedule periodically publishes and unpublishes the pets that are due until ctx is done
+func applySchedule(ctx context.Context, petService *petSrv.Service, conf *config.Schedule) {
+	ctx = authUtils.WithSystemIdentity(ctx)
+
+	ticker := time.NewTicker(time.Duration(conf.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := petService.ApplySchedule(ctx)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("service", "schedule").
+					Msg("Failed to apply the publishing schedule")
+				continue
+			}
+
+			if changed > 0 {
+				log.Info().
+					Str("service", "schedule").
+					Msgf("changed the visibility of %d scheduled pets", changed)
+			}
+		}
+	}
+}
+
 func main() {
+	// log.Ctx falls back to the global logger outside of a request, e.g. in the background jobs
+	zerolog.DefaultContextLogger = &log.Logger
+
 	conf, err := config.LoadConfig()
 	if err != nil {
 		log.Fatal().
@@ -101,7 +258,84 @@ func main() {
 			Msg("Failed to init postgres connection")
 	}
 
-	fileConn, err := grpc.Dial(conf.Service.File, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "backend").
+			Msg("Failed to load migrations")
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate(migrator, os.Args[2:]); err != nil {
+			log.Fatal().
+				Err(err).
+				Str("service", "migration").
+				Msg("Failed to migrate")
+		}
+		return
+	}
+
+	if err := checkSchema(migrator, conf.Database.MigrateOnStart); err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "migration").
+			Msg("Database schema is not up to date")
+	}
+
+	if conf.Jwt.Secret == "" {
+		log.Fatal().
+			Str("service", "backend").
+			Msg("JWT secret is not set")
+	}
+
+	ageBands, err := petUtils.ParseAgeBands(conf.Pet.AgeBands)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "backend").
+			Msg("Invalid pet age bands")
+	}
+
+	traceExporter, err := tracing.NewExporter(context.Background(), &conf.Tracing)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "tracing").
+			Msg("Failed to create the trace exporter")
+	}
+
+	// without an exporter the no-op provider still passes the trace of the gateway on to johnjud-file
+	var tracerProvider *sdktrace.TracerProvider
+	if traceExporter != nil {
+		tracerProvider = tracing.NewProvider(traceExporter, &conf.Tracing)
+		otel.SetTracerProvider(tracerProvider)
+	}
+	otel.SetTextMapPropagator(tracing.Propagator)
+
+	appMetrics := metrics.New()
+	if err := db.Use(metrics.NewGormPlugin(appMetrics)); err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "backend").
+			Msg("Failed to instrument the database")
+	}
+	if err := db.Use(tracing.NewGormPlugin(otel.GetTracerProvider())); err != nil {
+		log.Fatal().
+			Err(err).
+			Str("service", "backend").
+			Msg("Failed to instrument the database")
+	}
+
+	fileConn, err := grpc.Dial(
+		conf.Service.File,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			interceptor.RequestIDUnaryClientInterceptor(),
+			interceptor.MetricsUnaryClientInterceptor(appMetrics),
+		),
+		tracing.DialOption(otel.GetTracerProvider()),
+	)
 	if err != nil {
 		log.Fatal().
 			Err(err).
@@ -117,21 +351,81 @@ func main() {
 			Msg("Failed to start service")
 	}
 
-	grpcServer := grpc.NewServer()
+	tokenService := tokenSrv.NewService(&conf.Jwt)
 
-	likeRepo := likeRepo.NewRepository(db)
-	likeService := likeSrv.NewService(likeRepo)
+	grpcServer := grpc.NewServer(
+		tracing.ServerOption(otel.GetTracerProvider()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.LoggingUnaryInterceptor(&conf.Log),
+			interceptor.MetricsUnaryInterceptor(appMetrics),
+			interceptor.AuthUnaryInterceptor(tokenService),
+		),
+	)
+
+	userRepo := userRepo.NewRepository(db)
+	userService := userSrv.NewService(userRepo)
+	authService := authSrv.NewService(userRepo, tokenService)
 
-	imageClient := imagePb.NewImageServiceClient(fileConn)
+	imageClient := imageClt.NewClient(imagePb.NewImageServiceClient(fileConn), &conf.Client)
 	imageService := imageSrv.NewService(imageClient)
 	petRepo := petRepo.NewRepository(db)
-	petService := petSrv.NewService(petRepo, imageService)
+	likeRepo := likeRepo.NewRepository(db)
+	likeService := likeSrv.NewService(likeRepo, userRepo, petRepo, imageService)
+	adoptionRepo := adoptionRepo.NewRepository(db)
+	adoptionService := adoptionSrv.NewService(adoptionRepo, petRepo, userRepo)
+	petService := petSrv.NewService(petRepo, imageService, adoptionService, likeRepo, ageBands)
+
+	appMetrics.MustRegister(metrics.NewBusinessCollector(petRepo, likeRepo))
 
-	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	healthServer := health.NewServer()
+	monitor := healthMonitor.NewMonitor(healthServer, &conf.Health)
+	monitor.AddCheck("database", healthMonitor.DatabaseCheck(db))
+	monitor.AddCheck("johnjud-file", healthMonitor.ConnCheck(fileConn))
+	monitor.Watch(userPb.UserService_ServiceDesc.ServiceName, "database")
+	monitor.Watch(authPb.AuthService_ServiceDesc.ServiceName, "database")
+	monitor.Watch(likePb.LikeService_ServiceDesc.ServiceName, "database", "johnjud-file")
+	monitor.Watch(petPb.PetService_ServiceDesc.ServiceName, "database", "johnjud-file")
+	monitor.Watch(adoptionPb.AdoptionService_ServiceDesc.ServiceName, "database")
+	monitor.Check(context.Background())
+
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	userPb.RegisterUserServiceServer(grpcServer, userService)
+	authPb.RegisterAuthServiceServer(grpcServer, authService)
 	likePb.RegisterLikeServiceServer(grpcServer, likeService)
 	petPb.RegisterPetServiceServer(grpcServer, petService)
+	adoptionPb.RegisterAdoptionServiceServer(grpcServer, adoptionService)
 
 	reflection.Register(grpcServer)
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	if conf.Purge.Interval > 0 {
+		go purgeDeletedPets(purgeCtx, petService, &conf.Purge)
+	}
+
+	scheduleCtx, stopSchedule := context.WithCancel(context.Background())
+	if conf.Schedule.Interval > 0 {
+		go applySchedule(scheduleCtx, petService, &conf.Schedule)
+	}
+
+	metricsServer := &http.Server{Addr: fmt.Sprintf(":%v", conf.Metrics.Port), Handler: appMetrics.Handler()}
+	if conf.Metrics.Port > 0 {
+		go func() {
+			log.Info().
+				Str("service", "metrics").
+				Msgf("Metrics exposed at port %v", conf.Metrics.Port)
+
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error().
+					Err(err).
+					Str("service", "metrics").
+					Msg("Failed to expose metrics")
+			}
+		}()
+	}
+
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	go monitor.Run(healthCtx)
+
 	go func() {
 		log.Info().
 			Str("service", "backend").
@@ -145,11 +439,27 @@ func main() {
 		}
 	}()
 
-	wait := gracefulShutdown(context.Background(), 2*time.Second, map[string]operation{
+	drain := time.Duration(conf.Health.Drain) * time.Second
+	wait := gracefulShutdown(context.Background(), 2*time.Second+drain, map[string]operation{
 		"server": func(ctx context.Context) error {
+			// report NOT_SERVING first so load balancers stop routing here before the server stops
+			stopHealth()
+			monitor.Shutdown()
+			time.Sleep(drain)
 			grpcServer.GracefulStop()
 			return nil
 		},
+		"metrics": func(ctx context.Context) error {
+			return metricsServer.Shutdown(ctx)
+		},
+		"purge": func(ctx context.Context) error {
+			stopPurge()
+			return nil
+		},
+		"schedule": func(ctx context.Context) error {
+			stopSchedule()
+			return nil
+		},
 	})
 
 	<-wait
@@ -159,6 +469,19 @@ func main() {
 		Str("service", "backend").
 		Msg("Closing the listener")
 	lis.Close()
+
+	if tracerProvider != nil {
+		// flushes the spans of the last requests
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			log.Error().
+				Err(err).
+				Str("service", "tracing").
+				Msg("Failed to flush the spans")
+		}
+		cancel()
+	}
+
 	log.Info().
 		Str("service", "backend").
 		Msg("End the program")
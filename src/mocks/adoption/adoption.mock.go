@@ -0,0 +1,96 @@
+package adoption
+
+import (
+	"context"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/adoption"
+	adoptionConst "github.com/isd-sgcu/johnjud-backend/src/constant/adoption"
+	proto "github.com/isd-sgcu/johnjud-backend/src/proto/johnjud/backend/adoption/v1"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) FindOne(ctx context.Context, id string, result *adoption.Adoption) error {
+	args := r.Called(id, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindByPetId(ctx context.Context, petId string, result *[]*adoption.Adoption) error {
+	args := r.Called(petId, *result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindByUserId(ctx context.Context, userId string, result *[]*adoption.Adoption) error {
+	args := r.Called(userId, *result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) CountByStatus(ctx context.Context, petId string, userId string, statuses []adoptionConst.Status, result *int64) error {
+	args := r.Called(petId, userId, statuses)
+
+	*result = args.Get(0).(int64)
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, in *adoption.Adoption) error {
+	args := r.Called(in)
+
+	if args.Get(0) != nil {
+		*in = *args.Get(0).(*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) UpdateStatus(ctx context.Context, id string, from []adoptionConst.Status, in *adoption.Adoption) error {
+	args := r.Called(id, from, in)
+
+	if args.Get(0) != nil {
+		*in = *args.Get(0).(*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Complete(ctx context.Context, id string, result *adoption.Adoption) error {
+	args := r.Called(id, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*adoption.Adoption)
+	}
+
+	return args.Error(1)
+}
+
+type ServiceMock struct {
+	mock.Mock
+}
+
+func (s *ServiceMock) Submit(_ context.Context, req *proto.SubmitAdoptionRequest) (res *proto.SubmitAdoptionResponse, err error) {
+	args := s.Called(req)
+
+	if args.Get(0) != nil {
+		res = args.Get(0).(*proto.SubmitAdoptionResponse)
+	}
+
+	return res, args.Error(1)
+}
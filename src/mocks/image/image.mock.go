@@ -56,7 +56,7 @@ type ServiceMock struct {
 	mock.Mock
 }
 
-func (c *ServiceMock) FindByPetId(petId string) (res []*proto.Image, err error) {
+func (c *ServiceMock) FindByPetId(_ context.Context, petId string) (res []*proto.Image, err error) {
 	args := c.Called(petId)
 
 	if args.Get(0) != nil {
@@ -65,3 +65,22 @@ func (c *ServiceMock) FindByPetId(petId string) (res []*proto.Image, err error)
 
 	return res, args.Error(1)
 }
+
+func (c *ServiceMock) FindByPetIds(_ context.Context, petIds []string) (res map[string][]*proto.Image) {
+	args := c.Called(petIds)
+
+	if args.Get(0) != nil {
+		res = args.Get(0).(map[string][]*proto.Image)
+	}
+
+	return res
+}
+
+func (c *ServiceMock) Evict(petId string) {
+	c.Called(petId)
+}
+
+func (c *ServiceMock) DeleteByPetId(_ context.Context, petId string) error {
+	args := c.Called(petId)
+	return args.Error(0)
+}
@@ -0,0 +1,131 @@
+package like
+
+import (
+	"context"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/like"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) FindOne(ctx context.Context, id string, result *like.Like) error {
+	args := r.Called(id, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*like.Like)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindByUserId(ctx context.Context, query *like.LikesQuery, result *[]*like.Like, next *model.Cursor) error {
+	args := r.Called(query)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*like.Like)
+	}
+	if len(args) > 2 && args.Get(2) != nil {
+		*next = *args.Get(2).(*model.Cursor)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindLikedPets(ctx context.Context, query *like.LikedPetsQuery, result *[]*like.Like, total *int64, next *model.Cursor) error {
+	args := r.Called(query)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*like.Like)
+	}
+	*total = args.Get(1).(int64)
+	if len(args) > 3 && args.Get(3) != nil {
+		*next = *args.Get(3).(*model.Cursor)
+	}
+
+	return args.Error(2)
+}
+
+func (r *RepositoryMock) FindByPetAndUser(ctx context.Context, petId string, userId string, result *like.Like) error {
+	args := r.Called(petId, userId)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*like.Like)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) CountSince(ctx context.Context, since time.Time, result *int64) error {
+	args := r.Called(since)
+
+	*result = args.Get(0).(int64)
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) CountByPetIds(ctx context.Context, petIds []string, result map[string]int64) error {
+	args := r.Called(petIds)
+
+	if args.Get(0) != nil {
+		for petId, count := range args.Get(0).(map[string]int64) {
+			result[petId] = count
+		}
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindLikedPetIds(ctx context.Context, userId string, petIds []string, result *[]string) error {
+	args := r.Called(userId, petIds)
+
+	if args.Get(0) != nil {
+		*result = args.Get(0).([]string)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) RankPets(ctx context.Context, query *like.RankQuery, result *[]*like.PetRank) error {
+	args := r.Called(query)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*like.PetRank)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindCoLikedPets(ctx context.Context, userId string, limit int, result *[]*like.PetRank) error {
+	args := r.Called(userId, limit)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*like.PetRank)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, in *like.Like) error {
+	args := r.Called(in)
+
+	if args.Get(0) != nil {
+		*in = *args.Get(0).(*like.Like)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Delete(ctx context.Context, id string) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) DeleteByPetAndUser(ctx context.Context, petId string, userId string) error {
+	args := r.Called(petId, userId)
+	return args.Error(0)
+}
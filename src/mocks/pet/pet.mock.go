@@ -1,6 +1,10 @@
 package pet
 
 import (
+	"context"
+	"time"
+
+	"github.com/isd-sgcu/johnjud-backend/src/app/model"
 	"github.com/isd-sgcu/johnjud-backend/src/app/model/pet"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,7 +13,7 @@ type RepositoryMock struct {
 	mock.Mock
 }
 
-func (r *RepositoryMock) FindOne(id string, result *pet.Pet) error {
+func (r *RepositoryMock) FindOne(ctx context.Context, id string, result *pet.Pet) error {
 	args := r.Called(id, result)
 
 	if args.Get(0) != nil {
@@ -19,7 +23,35 @@ func (r *RepositoryMock) FindOne(id string, result *pet.Pet) error {
 	return args.Error(1)
 }
 
-func (r *RepositoryMock) Create(in *pet.Pet) error {
+func (r *RepositoryMock) FindByIds(ctx context.Context, ids []string, result *[]*pet.Pet) error {
+	args := r.Called(ids)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*pet.Pet)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindAdoptable(ctx context.Context, limit int, result *[]*pet.Pet) error {
+	args := r.Called(limit)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*pet.Pet)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) CountAdoptable(ctx context.Context, result *int64) error {
+	args := r.Called()
+
+	*result = args.Get(0).(int64)
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, in *pet.Pet) error {
 	args := r.Called(in)
 
 	if args.Get(0) != nil {
@@ -29,18 +61,22 @@ func (r *RepositoryMock) Create(in *pet.Pet) error {
 	return args.Error(1)
 }
 
-func (r *RepositoryMock) FindAll(result *[]*pet.Pet) error {
-	args := r.Called(*result)
+func (r *RepositoryMock) FindAll(ctx context.Context, query *pet.FindAllQuery, result *[]*pet.Pet, total *int64, next *model.Cursor) error {
+	args := r.Called(query, *result)
 
 	if args.Get(0) != nil {
 		*result = *args.Get(0).(*[]*pet.Pet)
 	}
+	*total = args.Get(1).(int64)
+	if len(args) > 3 && args.Get(3) != nil {
+		*next = *args.Get(3).(*model.Cursor)
+	}
 
-	return args.Error(1)
+	return args.Error(2)
 }
 
-func (r *RepositoryMock) Update(id string, result *pet.Pet) error {
-	args := r.Called(id, result)
+func (r *RepositoryMock) Update(ctx context.Context, id string, columns []string, result *pet.Pet) error {
+	args := r.Called(id, columns, result)
 
 	if args.Get(0) != nil {
 		*result = *args.Get(0).(*pet.Pet)
@@ -49,7 +85,51 @@ func (r *RepositoryMock) Update(id string, result *pet.Pet) error {
 	return args.Error(1)
 }
 
-func (r *RepositoryMock) Delete(id string) error {
+func (r *RepositoryMock) Delete(ctx context.Context, id string) error {
 	args := r.Called(id)
 	return args.Error(0)
 }
+
+func (r *RepositoryMock) FindDeleted(ctx context.Context, page int32, pageSize int32, result *[]*pet.Pet, total *int64) error {
+	args := r.Called(page, pageSize)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*pet.Pet)
+	}
+	*total = args.Get(1).(int64)
+
+	return args.Error(2)
+}
+
+func (r *RepositoryMock) FindPurgeable(ctx context.Context, before time.Time, result *[]*pet.Pet) error {
+	args := r.Called(before)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*[]*pet.Pet)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Restore(ctx context.Context, id string) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Purge(ctx context.Context, id string) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) ApplySchedule(ctx context.Context, now time.Time, published *[]*pet.Pet, unpublished *[]*pet.Pet) error {
+	args := r.Called(now)
+
+	if args.Get(0) != nil {
+		*published = *args.Get(0).(*[]*pet.Pet)
+	}
+	if args.Get(1) != nil {
+		*unpublished = *args.Get(1).(*[]*pet.Pet)
+	}
+
+	return args.Error(2)
+}
@@ -0,0 +1,33 @@
+package token
+
+import (
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	authUtils "github.com/isd-sgcu/johnjud-backend/src/app/utils/auth"
+	authConst "github.com/isd-sgcu/johnjud-backend/src/constant/auth"
+	proto "github.com/isd-sgcu/johnjud-go-proto/johnjud/auth/auth/v1"
+	"github.com/stretchr/testify/mock"
+)
+
+type ServiceMock struct {
+	mock.Mock
+}
+
+func (s *ServiceMock) CreateCredential(in *user.User) (res *proto.Credential, err error) {
+	args := s.Called(in)
+
+	if args.Get(0) != nil {
+		res = args.Get(0).(*proto.Credential)
+	}
+
+	return res, args.Error(1)
+}
+
+func (s *ServiceMock) Validate(token string, tokenType authConst.TokenType) (res *authUtils.Identity, err error) {
+	args := s.Called(token, tokenType)
+
+	if args.Get(0) != nil {
+		res = args.Get(0).(*authUtils.Identity)
+	}
+
+	return res, args.Error(1)
+}
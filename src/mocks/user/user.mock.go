@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"github.com/isd-sgcu/johnjud-backend/src/app/model/user"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) FindOne(ctx context.Context, id string, result *user.User) error {
+	args := r.Called(id, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*user.User)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) FindByEmail(ctx context.Context, email string, result *user.User) error {
+	args := r.Called(email, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*user.User)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, in *user.User) error {
+	args := r.Called(in)
+
+	if args.Get(0) != nil {
+		*in = *args.Get(0).(*user.User)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Update(ctx context.Context, id string, result *user.User) error {
+	args := r.Called(id, result)
+
+	if args.Get(0) != nil {
+		*result = *args.Get(0).(*user.User)
+	}
+
+	return args.Error(1)
+}
+
+func (r *RepositoryMock) Delete(ctx context.Context, id string) error {
+	args := r.Called(id)
+	return args.Error(0)
+}